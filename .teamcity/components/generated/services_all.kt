@@ -29,6 +29,7 @@ val services = mapOf(
     "bcmdataexports" to ServiceSpec("BCM Data Exports", parallelismOverride = 5),
     "bedrock" to ServiceSpec("Bedrock"),
     "bedrockagent" to ServiceSpec("Bedrock Agents"),
+    "billingconductor" to ServiceSpec("Billing Conductor"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chatbot" to ServiceSpec("Chatbot"),
@@ -147,6 +148,7 @@ val services = mapOf(
     "lookoutmetrics" to ServiceSpec("Lookout for Metrics"),
     "m2" to ServiceSpec("Mainframe Modernization"),
     "macie2" to ServiceSpec("Macie"),
+    "mailmanager" to ServiceSpec("SES Mail Manager"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -201,6 +203,7 @@ val services = mapOf(
     "s3" to ServiceSpec("S3 (Simple Storage)"),
     "s3control" to ServiceSpec("S3 Control"),
     "s3outposts" to ServiceSpec("S3 on Outposts"),
+    "s3tables" to ServiceSpec("S3 Tables"),
     "sagemaker" to ServiceSpec("SageMaker", vpcLock = true),
     "scheduler" to ServiceSpec("EventBridge Scheduler"),
     "schemas" to ServiceSpec("EventBridge Schemas"),
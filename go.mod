@@ -11,7 +11,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.5
-	github.com/aws/aws-sdk-go-v2 v1.32.4
+	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/aws/aws-sdk-go-v2/config v1.28.3
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.44
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.19
@@ -21,7 +21,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/acm v1.30.5
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.37.6
 	github.com/aws/aws-sdk-go-v2/service/amp v1.30.2
-	github.com/aws/aws-sdk-go-v2/service/amplify v1.27.3
+	github.com/aws/aws-sdk-go-v2/service/amplify v1.29.0
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.35.3
@@ -44,6 +44,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.7.5
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.22.3
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.27.0
+	github.com/aws/aws-sdk-go-v2/service/billingconductor v1.20.0
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.28.5
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/chime v1.34.5
@@ -87,7 +88,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/databrew v1.33.5
 	github.com/aws/aws-sdk-go-v2/service/dataexchange v1.33.3
 	github.com/aws/aws-sdk-go-v2/service/datapipeline v1.25.5
-	github.com/aws/aws-sdk-go-v2/service/datasync v1.43.2
+	github.com/aws/aws-sdk-go-v2/service/datasync v1.45.1
 	github.com/aws/aws-sdk-go-v2/service/datazone v1.23.3
 	github.com/aws/aws-sdk-go-v2/service/dax v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/detective v1.31.5
@@ -100,7 +101,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.14.2
 	github.com/aws/aws-sdk-go-v2/service/drs v1.30.5
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.36.5
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.187.1
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.212.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.36.5
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.49.2
@@ -152,7 +153,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kinesisvideo v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/kms v1.37.5
 	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.38.1
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.66.0
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.0
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice v1.28.5
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.49.5
@@ -162,6 +163,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/lookoutmetrics v1.31.5
 	github.com/aws/aws-sdk-go-v2/service/m2 v1.18.3
 	github.com/aws/aws-sdk-go-v2/service/macie2 v1.43.5
+	github.com/aws/aws-sdk-go-v2/service/mailmanager v1.13.0
 	github.com/aws/aws-sdk-go-v2/service/mediaconnect v1.35.5
 	github.com/aws/aws-sdk-go-v2/service/mediaconvert v1.61.5
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.62.5
@@ -203,7 +205,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/rekognition v1.45.6
 	github.com/aws/aws-sdk-go-v2/service/resiliencehub v1.27.3
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.16.0
-	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.27.5
+	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.27.6
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.16.5
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.46.1
@@ -213,9 +215,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/route53recoveryreadiness v1.21.5
 	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.33.3
 	github.com/aws/aws-sdk-go-v2/service/rum v1.21.5
-	github.com/aws/aws-sdk-go-v2/service/s3 v1.66.3
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.71.0
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.50.0
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.28.5
+	github.com/aws/aws-sdk-go-v2/service/s3tables v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.166.2
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.12.5
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.28.6
@@ -249,7 +252,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.6.5
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.29.5
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.41.5
-	github.com/aws/aws-sdk-go-v2/service/transfer v1.53.3
+	github.com/aws/aws-sdk-go-v2/service/transfer v1.54.0
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.20.1
 	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.12.5
 	github.com/aws/aws-sdk-go-v2/service/waf v1.25.5
@@ -260,7 +263,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.48.5
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/xray v1.29.5
-	github.com/aws/smithy-go v1.22.0
+	github.com/aws/smithy-go v1.22.2
 	github.com/beevik/etree v1.4.1
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -300,6 +303,7 @@ require (
 	github.com/shopspring/decimal v1.4.0
 	golang.org/x/crypto v0.29.0
 	golang.org/x/mod v0.22.0
+	golang.org/x/sync v0.9.0
 	golang.org/x/text v0.20.0
 	golang.org/x/tools v0.27.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.2.1
@@ -314,16 +318,16 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.6 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.23 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.23 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.23 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.0 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.4.4 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.25 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.4.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.10.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.15 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.28.4 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
@@ -368,7 +372,6 @@ require (
 	go.opentelemetry.io/otel/metric v1.31.0 // indirect
 	go.opentelemetry.io/otel/trace v1.31.0 // indirect
 	golang.org/x/net v0.31.0 // indirect
-	golang.org/x/sync v0.9.0 // indirect
 	golang.org/x/sys v0.27.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240827150818-7e3bb234dfed // indirect
@@ -202,6 +202,7 @@ variable "service_labels" {
     "machinelearning",
     "macie",
     "macie2",
+    "mailmanager",
     "managedblockchain",
     "marketplacecatalog",
     "marketplacecommerceanalytics",
@@ -286,6 +287,7 @@ variable "service_labels" {
     "s3",
     "s3control",
     "s3outposts",
+    "s3tables",
     "sagemaker",
     "sagemakera2iruntime",
     "sagemakeredge",
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AssumeRoleOverride holds a resource-level override of the IAM role assumed for API calls.
+type AssumeRoleOverride struct {
+	Duration    time.Duration
+	ExternalID  string
+	RoleARN     string
+	SessionName string
+}
+
+func (o AssumeRoleOverride) key() string {
+	return fmt.Sprintf("%s|%s|%s|%s", o.RoleARN, o.SessionName, o.ExternalID, o.Duration)
+}
+
+// WithAssumeRole returns an AWSClient whose API calls are made using credentials for the specified IAM role.
+// The role is assumed using the provider's credentials. All other settings are inherited from the provider.
+// Clients are cached so that resources sharing the same override share API clients and credentials.
+func (c *AWSClient) WithAssumeRole(ctx context.Context, override AssumeRoleOverride) (*AWSClient, error) {
+	roleARN, err := arn.Parse(override.RoleARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing role ARN (%s): %w", override.RoleARN, err)
+	}
+
+	c.assumeRoleClientsLock.Lock()
+	defer c.assumeRoleClientsLock.Unlock()
+
+	key := override.key()
+	if v, ok := c.assumeRoleClients[key]; ok {
+		return v, nil
+	}
+
+	tflog.Debug(ctx, "Creating AWS client for resource-level assume role", map[string]any{
+		"tf_aws.assume_role.role_arn":     override.RoleARN,
+		"tf_aws.assume_role.session_name": override.SessionName,
+	})
+
+	cfg := c.awsConfig.Copy()
+	stsConn := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+	})
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsConn, override.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		if override.Duration > 0 {
+			o.Duration = override.Duration
+		}
+		if override.ExternalID != "" {
+			o.ExternalID = aws.String(override.ExternalID)
+		}
+		if override.SessionName != "" {
+			o.RoleSessionName = override.SessionName
+		}
+	}))
+
+	client := c.clone()
+	client.AccountID = roleARN.AccountID
+	client.awsConfig = &cfg
+	if c.session != nil {
+		client.session = c.session.Copy(&aws_sdkv1.Config{
+			Credentials: credentials_sdkv1.NewCredentials(&credentialsProviderV1{provider: cfg.Credentials}),
+		})
+	}
+
+	if c.assumeRoleClients == nil {
+		c.assumeRoleClients = make(map[string]*AWSClient)
+	}
+	c.assumeRoleClients[key] = client
+
+	return client, nil
+}
+
+// credentialsProviderV1 adapts an AWS SDK for Go v2 credentials provider for use by AWS SDK for Go v1 API clients.
+type credentialsProviderV1 struct {
+	credentials_sdkv1.Expiry
+	provider aws.CredentialsProvider
+}
+
+func (p *credentialsProviderV1) Retrieve() (credentials_sdkv1.Value, error) {
+	credentials, err := p.provider.Retrieve(context.Background())
+
+	if err != nil {
+		return credentials_sdkv1.Value{}, err
+	}
+
+	if credentials.CanExpire {
+		p.SetExpiration(credentials.Expires, 0)
+	}
+
+	return credentials_sdkv1.Value{
+		AccessKeyID:     credentials.AccessKeyID,
+		ProviderName:    credentials.Source,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+	}, nil
+}
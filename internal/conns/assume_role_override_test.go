@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAWSClientWithAssumeRole(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := &AWSClient{
+		AccountID: "111111111111",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		awsConfig: &aws.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		partition: standardPartition,
+	}
+
+	override := AssumeRoleOverride{
+		RoleARN:     "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+		SessionName: "test",
+	}
+
+	client1, err := c.WithAssumeRole(ctx, override)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := client1.AccountID, "222222222222"; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+	if got, want := client1.Region, c.Region; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+	if client1.awsConfig.Credentials == nil {
+		t.Error("expected credentials provider")
+	}
+
+	client2, err := c.WithAssumeRole(ctx, override)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client1 != client2 {
+		t.Error("expected cached client")
+	}
+
+	override.SessionName = "other"
+	client3, err := c.WithAssumeRole(ctx, override)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client1 == client3 {
+		t.Error("expected new client")
+	}
+
+	if _, err := c.WithAssumeRole(ctx, AssumeRoleOverride{RoleARN: "invalid"}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// auditLogEntry is a single line of the provider's API call audit log.
+type auditLogEntry struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	StatusCode   int       `json:"status_code,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	DataSource   bool      `json:"data_source,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// auditLogger writes an audit log entry, as a JSON line, for every AWS API call.
+// The audit log is opened once per provider instance and entries are buffered until the logger is closed.
+type auditLogger struct {
+	lock sync.Mutex
+	w    *bufio.Writer
+	c    io.Closer
+}
+
+func newAuditLogger(path string) (*auditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditLogger{w: bufio.NewWriter(f), c: f}, nil
+}
+
+func (l *auditLogger) log(ctx context.Context, entry auditLogEntry) {
+	if v, ok := FromContext(ctx); ok {
+		entry.DataSource = v.IsDataSource
+		entry.ResourceType = v.TypeName
+	}
+
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Entries logged after the logger is closed are discarded.
+	if l.w == nil {
+		return
+	}
+
+	l.w.Write(append(b, '\n')) //nolint:errcheck // Auditing must not fail API calls.
+}
+
+// close flushes any buffered entries and closes the audit log.
+func (l *auditLogger) close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.w == nil {
+		return nil
+	}
+
+	err := l.w.Flush()
+	if l.c != nil {
+		err = errors.Join(err, l.c.Close())
+	}
+	l.w, l.c = nil, nil
+
+	return err
+}
+
+// apiOptions returns the AWS SDK for Go v2 API option that adds the audit log middleware.
+// The middleware runs after all retries have completed so that each API call is logged once.
+func (l *auditLogger) apiOptions() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			entry := auditLogEntry{
+				Time:      start.UTC(),
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Operation: awsmiddleware.GetOperationName(ctx),
+				Region:    awsmiddleware.GetRegion(ctx),
+				LatencyMS: time.Since(start).Milliseconds(),
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				entry.RequestID = v
+			}
+			if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+				entry.StatusCode = v.StatusCode
+			}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+
+			l.log(ctx, entry)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// completeHandler returns the AWS SDK for Go v1 request handler that writes audit log entries.
+func (l *auditLogger) completeHandler() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TFAuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			entry := auditLogEntry{
+				Time:      r.Time.UTC(),
+				Service:   r.ClientInfo.ServiceID,
+				Operation: r.Operation.Name,
+				RequestID: r.RequestID,
+				LatencyMS: time.Since(r.Time).Milliseconds(),
+			}
+			if r.Config.Region != nil {
+				entry.Region = *r.Config.Region
+			}
+			if r.HTTPResponse != nil {
+				entry.StatusCode = r.HTTPResponse.StatusCode
+			}
+			if r.Error != nil {
+				entry.Error = r.Error.Error()
+			}
+
+			l.log(r.Context(), entry)
+		},
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditLoggerLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	logger := &auditLogger{w: bufio.NewWriter(&buf)}
+	now := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	logger.log(ctx, auditLogEntry{
+		Time:       now,
+		Service:    "EC2",
+		Operation:  "DescribeVpcs",
+		Region:     "us-west-2", //lintignore:AWSAT003
+		RequestID:  "request-1",
+		StatusCode: 200,
+		LatencyMS:  15,
+	})
+	logger.log(NewDataSourceContext(ctx, "ec2", "VPC", "aws_vpc"), auditLogEntry{
+		Time:       now,
+		Service:    "EC2",
+		Operation:  "DescribeVpcs",
+		StatusCode: 400,
+		LatencyMS:  10,
+		Error:      "InvalidVpcID.NotFound",
+	})
+	logger.log(NewResourceContext(ctx, "ec2", "Subnet", "aws_subnet"), auditLogEntry{
+		Time:      now,
+		Service:   "EC2",
+		Operation: "CreateSubnet",
+	})
+
+	if err := logger.close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+
+	if got, want := len(lines), 3; got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+
+	var got []auditLogEntry
+	for _, line := range lines {
+		var entry auditLogEntry
+
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("unmarshaling %q: %s", line, err)
+		}
+
+		got = append(got, entry)
+	}
+
+	want := []auditLogEntry{
+		{
+			Time:       now,
+			Service:    "EC2",
+			Operation:  "DescribeVpcs",
+			Region:     "us-west-2", //lintignore:AWSAT003
+			RequestID:  "request-1",
+			StatusCode: 200,
+			LatencyMS:  15,
+		},
+		{
+			Time:         now,
+			Service:      "EC2",
+			Operation:    "DescribeVpcs",
+			StatusCode:   400,
+			LatencyMS:    10,
+			ResourceType: "aws_vpc",
+			DataSource:   true,
+			Error:        "InvalidVpcID.NotFound",
+		},
+		{
+			Time:         now,
+			Service:      "EC2",
+			Operation:    "CreateSubnet",
+			ResourceType: "aws_subnet",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAuditLoggerFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	logger, err := newAuditLogger(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, operation := range []string{"DescribeVpcs", "DescribeSubnets"} {
+		logger.log(ctx, auditLogEntry{
+			Service:   "EC2",
+			Operation: operation,
+		})
+	}
+
+	if err := logger.close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Entries logged after the logger is closed are discarded.
+	logger.log(ctx, auditLogEntry{
+		Service:   "EC2",
+		Operation: "DescribeInstances",
+	})
+
+	if err := logger.close(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("reading %s: %s", path, err)
+	}
+
+	if got, want := strings.Count(string(b), "\n"), 2; got != want {
+		t.Errorf("audit log %q has %d lines, want %d", string(b), got, want)
+	}
+
+	for _, operation := range []string{"DescribeVpcs", "DescribeSubnets"} {
+		if !strings.Contains(string(b), operation) {
+			t.Errorf("audit log %q doesn't contain %s", string(b), operation)
+		}
+	}
+
+	if _, err := newAuditLogger(filepath.Join(t.TempDir(), "missing", "audit.log")); err == nil {
+		t.Error("expected error")
+	}
+}
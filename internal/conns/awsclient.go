@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 
@@ -26,25 +27,85 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	defaultTagsConfig *tftags.DefaultConfig
-	ignoreTagsConfig  *tftags.IgnoreConfig
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	AccountID             string
+	defaultTagsConfig     *tftags.DefaultConfig
+	defaultTimeoutsConfig []DefaultTimeoutsConfig // From provider configuration.
+	ignoreTagsConfig      *tftags.IgnoreConfig
+	Region                string
+	ServicePackages       map[string]ServicePackage
+
+	defaultTagsExcludeResourceTypes []string                  // From provider configuration.
+	defaultTagsOverrideRules        []DefaultTagsOverrideRule // From provider configuration.
+
+	permissionSimulationConfig     *PermissionSimulationConfig // From provider configuration.
+	permissionSimulationLock       sync.Mutex
+	permissionSimulationPrincipal  string // Resolved from the caller identity.
+	permissionsNotDeclaredReported bool   // Whether undeclared resource permissions have been reported.
+
+	assumeRoleClients              map[string]*AWSClient // Keyed by resource-level assume role override.
+	assumeRoleClientsLock          sync.Mutex
+	auditLogger                    *auditLogger // Shared with clones.
+	awsConfig                      *aws.Config
+	batchers                       map[batcherKey]any
+	batchersLock                   sync.Mutex
+	clients                        map[string]any
+	conns                          map[string]any
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	maxRetries                     int // From provider configuration.
+	partition                      endpoints.Partition
+	session                        *session_sdkv1.Session
+	serviceRetryConfigs            []ServiceRetryConfig // From provider configuration.
+	s3ExpressClient                *s3.Client
+	s3UsePathStyle                 bool   // From provider configuration.
+	s3USEast1RegionalEndpoint      string // From provider configuration.
+	stsRegion                      string // From provider configuration.
+	tokenBucketRateLimiterCapacity int    // From provider configuration.
+}
+
+// clone returns a copy of the AWSClient that shares its configuration but none of its caches.
+// Caches of API clients and of state resolved using the client's credentials are reset.
+// The caller must hold c.assumeRoleClientsLock.
+func (c *AWSClient) clone() *AWSClient {
+	// Caches are lazily populated under their locks.
+	c.lock.Lock()
+	c.batchersLock.Lock()
+	c.permissionSimulationLock.Lock()
+
+	client := &AWSClient{}
+	// Copy using reflection as the struct contains locks, which go vet doesn't allow to be copied by assignment.
+	// The copied locks are reset below.
+	reflect.ValueOf(client).Elem().Set(reflect.ValueOf(c).Elem())
+
+	c.permissionSimulationLock.Unlock()
+	c.batchersLock.Unlock()
+	c.lock.Unlock()
+
+	client.assumeRoleClients = nil
+	client.assumeRoleClientsLock = sync.Mutex{}
+	client.batchers = nil
+	client.batchersLock = sync.Mutex{}
+	client.clients = make(map[string]any, 0)
+	client.conns = make(map[string]any, 0)
+	client.lock = sync.Mutex{}
+	client.permissionSimulationLock = sync.Mutex{}
+	client.permissionSimulationPrincipal = ""
+	client.permissionsNotDeclaredReported = false
+	client.s3ExpressClient = nil
+
+	return client
+}
+
+// Close releases the resources held for the lifetime of the provider instance.
+// Any buffered audit log entries are written.
+func (c *AWSClient) Close() error {
+	if c.auditLogger == nil {
+		return nil
+	}
+
+	return c.auditLogger.close()
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -55,14 +116,15 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
-	return c.defaultTagsConfig
-}
-
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// PermissionSimulationConfig returns the provider's pre-apply IAM permission simulation settings.
+func (c *AWSClient) PermissionSimulationConfig(context.Context) *PermissionSimulationConfig {
+	return c.permissionSimulationConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -281,7 +343,7 @@ func convertIPToDashIP(ip string) string {
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": c.awsConfigForService(servicePackageName),
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
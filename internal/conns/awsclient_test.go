@@ -5,9 +5,18 @@ package conns
 
 import (
 	"context"
+	"net/http"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 var (
@@ -230,3 +239,84 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSClientClone(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	c := &AWSClient{
+		AccountID:                       "111111111111",
+		defaultTagsConfig:               &tftags.DefaultConfig{},
+		defaultTimeoutsConfig:           []DefaultTimeoutsConfig{{Create: time.Minute}},
+		ignoreTagsConfig:                &tftags.IgnoreConfig{},
+		Region:                          "us-west-2", //lintignore:AWSAT003
+		ServicePackages:                 map[string]ServicePackage{},
+		defaultTagsExcludeResourceTypes: []string{"aws_instance"},
+		defaultTagsOverrideRules:        []DefaultTagsOverrideRule{{ResourceTypes: []string{"aws_vpc"}}},
+		permissionSimulationConfig:      &PermissionSimulationConfig{Enabled: true},
+		permissionSimulationPrincipal:   "arn:aws:iam::111111111111:role/test", //lintignore:AWSAT005
+		permissionsNotDeclaredReported:  true,
+		assumeRoleClients:               map[string]*AWSClient{"test": {}},
+		auditLogger:                     &auditLogger{},
+		awsConfig:                       &aws.Config{},
+		batchers:                        map[batcherKey]any{{name: "test"}: nil},
+		clients:                         map[string]any{"test": nil},
+		conns:                           map[string]any{"test": nil},
+		endpoints:                       map[string]string{"ec2": "https://ec2.example.com"},
+		httpClient:                      &http.Client{},
+		logger:                          baselogging.NullLogger{},
+		maxRetries:                      25,
+		partition:                       standardPartition,
+		session:                         &session_sdkv1.Session{},
+		serviceRetryConfigs:             []ServiceRetryConfig{{MaxRetries: 1}},
+		s3ExpressClient:                 &s3.Client{},
+		s3UsePathStyle:                  true,
+		s3USEast1RegionalEndpoint:       "regional",
+		stsRegion:                       "us-west-2", //lintignore:AWSAT003
+		tokenBucketRateLimiterCapacity:  10000,
+	}
+
+	// Every field must be set so that the test fails if a new field isn't carried over by clone.
+	v := reflect.ValueOf(c).Elem()
+	for i := range v.NumField() {
+		if f := v.Type().Field(i); f.Type != reflect.TypeFor[sync.Mutex]() && v.Field(i).IsZero() {
+			t.Fatalf("AWSClient.%s is not set", f.Name)
+		}
+	}
+
+	client := c.clone()
+
+	if client.assumeRoleClients != nil {
+		t.Error("expected assumeRoleClients to be reset")
+	}
+	if client.batchers != nil {
+		t.Error("expected batchers to be reset")
+	}
+	if len(client.clients) != 0 {
+		t.Error("expected clients to be reset")
+	}
+	if len(client.conns) != 0 {
+		t.Error("expected conns to be reset")
+	}
+	if client.permissionSimulationPrincipal != "" {
+		t.Error("expected permissionSimulationPrincipal to be reset")
+	}
+	if client.permissionsNotDeclaredReported {
+		t.Error("expected permissionsNotDeclaredReported to be reset")
+	}
+	if client.s3ExpressClient != nil {
+		t.Error("expected s3ExpressClient to be reset")
+	}
+
+	// All other fields are carried over.
+	client.assumeRoleClients = c.assumeRoleClients
+	client.batchers = c.batchers
+	client.clients = c.clients
+	client.conns = c.conns
+	client.permissionSimulationPrincipal = c.permissionSimulationPrincipal
+	client.permissionsNotDeclaredReported = c.permissionsNotDeclaredReported
+	client.s3ExpressClient = c.s3ExpressClient
+
+	if !reflect.DeepEqual(client, c) {
+		t.Error("expected all other fields to be carried over")
+	}
+}
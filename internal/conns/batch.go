@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// batchWindow is how long lookups are collected before a batch is described.
+	batchWindow = 10 * time.Millisecond
+	// batchMaxSize is the maximum number of keys described by a single batch.
+	batchMaxSize = 100
+)
+
+// BatchFunc describes the resources with the specified keys, returning those found keyed by key.
+// Keys not present in the result are treated as not found.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// batcher coalesces concurrent single-key lookups of a resource type into batched describe calls.
+type batcher[K comparable, V any] struct {
+	fn      BatchFunc[K, V]
+	lock    sync.Mutex
+	maxSize int
+	pending *pendingBatch[K, V]
+	window  time.Duration
+}
+
+// pendingBatch is a set of keys described by a single call.
+type pendingBatch[K comparable, V any] struct {
+	cancel  context.CancelFunc
+	done    chan struct{} // Closed when the batch has been described.
+	err     error
+	full    chan struct{} // Closed when the batch reaches its maximum size.
+	keys    []K
+	results map[K]V
+	waiters int // Callers waiting for the batch to be described.
+}
+
+func newBatcher[K comparable, V any](fn BatchFunc[K, V], window time.Duration, maxSize int) *batcher[K, V] {
+	return &batcher[K, V]{
+		fn:      fn,
+		maxSize: maxSize,
+		window:  window,
+	}
+}
+
+// get returns the value with the specified key and whether it was found.
+// The key is added to the pending batch, which is described once the batch window elapses or the batch is full.
+func (b *batcher[K, V]) get(ctx context.Context, key K) (V, bool, error) {
+	var zero V
+
+	b.lock.Lock()
+	bt := b.pending
+	if bt == nil {
+		// The batch is described on behalf of all of its callers, so it isn't canceled with the caller that started it.
+		// It's canceled once every caller has stopped waiting for it.
+		ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		bt = &pendingBatch[K, V]{
+			cancel: cancel,
+			done:   make(chan struct{}),
+			full:   make(chan struct{}),
+		}
+		b.pending = bt
+		go b.run(ctx, bt)
+	}
+	bt.waiters++
+	if !slices.Contains(bt.keys, key) {
+		bt.keys = append(bt.keys, key)
+	}
+	if len(bt.keys) >= b.maxSize {
+		b.pending = nil
+		close(bt.full)
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-bt.done:
+	case <-ctx.Done():
+		b.lock.Lock()
+		bt.waiters--
+		if bt.waiters == 0 {
+			if b.pending == bt {
+				b.pending = nil
+			}
+			bt.cancel()
+		}
+		b.lock.Unlock()
+
+		return zero, false, ctx.Err()
+	}
+
+	if bt.err != nil {
+		return zero, false, bt.err
+	}
+
+	v, ok := bt.results[key]
+
+	return v, ok, nil
+}
+
+func (b *batcher[K, V]) run(ctx context.Context, bt *pendingBatch[K, V]) {
+	defer bt.cancel()
+
+	timer := time.NewTimer(b.window)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-bt.full:
+	case <-ctx.Done():
+	}
+
+	b.lock.Lock()
+	if b.pending == bt {
+		b.pending = nil
+	}
+	keys := slices.Clone(bt.keys)
+	b.lock.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		bt.err = err
+	} else {
+		bt.results, bt.err = b.fn(ctx, keys)
+	}
+
+	close(bt.done)
+}
+
+// batcherKey identifies the batcher used for a lookup.
+type batcherKey struct {
+	name     string
+	typeName string // Lookups are only coalesced with those made by the same resource type.
+}
+
+// Batched returns the value with the specified key and whether it was found.
+// Concurrent lookups sharing a name, e.g. the refresh of many resources of the same type,
+// are coalesced into batched calls to fn for the lifetime of the AWSClient.
+// Batchers belong to the AWSClient, so lookups are only coalesced with those made using the same API clients and credentials.
+// fn must describe all the keys it's called with; keys that don't exist are omitted from the result rather than failing the call.
+// This function is not a method on `AWSClient` as methods can't be parameterized.
+func Batched[K comparable, V any](ctx context.Context, c *AWSClient, name string, key K, fn BatchFunc[K, V]) (V, bool, error) {
+	k := batcherKey{name: name}
+	if v, ok := FromContext(ctx); ok {
+		k.typeName = v.TypeName
+	}
+
+	c.batchersLock.Lock()
+	if c.batchers == nil {
+		c.batchers = make(map[batcherKey]any)
+	}
+	raw, ok := c.batchers[k]
+	if !ok {
+		raw = newBatcher(fn, batchWindow, batchMaxSize)
+		c.batchers[k] = raw
+	}
+	c.batchersLock.Unlock()
+
+	b, ok := raw.(*batcher[K, V])
+	if !ok {
+		var zero V
+		return zero, false, fmt.Errorf("batcher (%s): %T, want %T", name, raw, b)
+	}
+
+	return b.get(ctx, key)
+}
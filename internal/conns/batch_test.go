@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBatcherCoalesces(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var lock sync.Mutex
+	var calls [][]string
+	b := newBatcher(func(_ context.Context, keys []string) (map[string]int, error) {
+		lock.Lock()
+		calls = append(calls, keys)
+		lock.Unlock()
+
+		results := make(map[string]int)
+		for _, key := range keys {
+			if key != "missing" {
+				results[key] = len(key)
+			}
+		}
+
+		return results, nil
+	}, 100*time.Millisecond, 10)
+
+	keys := []string{"a", "bb", "ccc", "bb", "missing"}
+	type result struct {
+		value int
+		found bool
+	}
+	results := make([]result, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			v, ok, err := b.get(ctx, key)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			results[i] = result{value: v, found: ok}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(calls), 1; got != want {
+		t.Fatalf("got %d batch calls, want %d", got, want)
+	}
+
+	got := slices.Sorted(slices.Values(calls[0]))
+	if diff := cmp.Diff(got, []string{"a", "bb", "ccc", "missing"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(results, []result{{1, true}, {2, true}, {3, true}, {2, true}, {0, false}}, cmp.AllowUnexported(result{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestBatcherMaxSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var lock sync.Mutex
+	var calls int
+	b := newBatcher(func(_ context.Context, keys []int) (map[int]int, error) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+
+		if len(keys) > 2 {
+			t.Errorf("got batch of %d keys, want at most 2", len(keys))
+		}
+
+		results := make(map[int]int)
+		for _, key := range keys {
+			results[key] = key
+		}
+
+		return results, nil
+	}, time.Hour, 2)
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := b.get(ctx, i); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("got %d batch calls, want %d", got, want)
+	}
+}
+
+func TestBatcherError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errBatch := errors.New("batch failed")
+
+	var lock sync.Mutex
+	var calls int
+	b := newBatcher(func(_ context.Context, keys []string) (map[string]string, error) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+
+		return nil, errBatch
+	}, 100*time.Millisecond, 10)
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := b.get(ctx, key); !errors.Is(err, errBatch) {
+				t.Errorf("got error %v, want %v", err, errBatch)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("got %d batch calls, want %d", got, want)
+	}
+}
+
+func TestBatcherCallerCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := newBatcher(func(ctx context.Context, keys []string) (map[string]string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		results := make(map[string]string)
+		for _, key := range keys {
+			results[key] = key
+		}
+
+		return results, nil
+	}, time.Second, 10)
+
+	waitForWaiters := func(n int) {
+		for {
+			b.lock.Lock()
+			waiters := 0
+			if b.pending != nil {
+				waiters = b.pending.waiters
+			}
+			b.lock.Unlock()
+
+			if waiters == n {
+				return
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// The caller that starts the batch stops waiting for it before it's described.
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, _, err := b.get(ctx, "first")
+		first <- err
+	}()
+	waitForWaiters(1)
+
+	type result struct {
+		value string
+		found bool
+		err   error
+	}
+	second := make(chan result)
+	go func() {
+		v, ok, err := b.get(context.Background(), "second")
+		second <- result{v, ok, err}
+	}()
+	waitForWaiters(2)
+
+	cancel()
+
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+
+	got := <-second
+
+	if got.err != nil {
+		t.Fatalf("unexpected error: %s", got.err)
+	}
+
+	if !got.found || got.value != "second" {
+		t.Errorf("got (%q, %t), want (%q, true)", got.value, got.found, "second")
+	}
+}
+
+func TestBatcherContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	b := newBatcher(func(_ context.Context, keys []string) (map[string]string, error) {
+		return nil, nil
+	}, 10*time.Millisecond, 10)
+
+	if _, _, err := b.get(ctx, "key"); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
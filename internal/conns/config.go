@@ -10,7 +10,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -27,41 +30,58 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     []awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	TokenBucketRateLimiterCapacity int
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                       string
+	AllowedAccountIds               []string
+	AssumeRole                      []awsbase.AssumeRole
+	AssumeRoleWithWebIdentity       *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                    string
+	CustomCABundle                  string
+	DefaultTagsConfig               *tftags.DefaultConfig
+	DefaultTagsExcludeResourceTypes []string
+	DefaultTagsOverrideRules        []DefaultTagsOverrideRule
+	DefaultTimeoutsConfig           []DefaultTimeoutsConfig
+	EC2MetadataServiceEnableState   imds.ClientEnableState
+	EC2MetadataServiceEndpoint      string
+	EC2MetadataServiceEndpointMode  string
+	Endpoints                       map[string]string
+	ForbiddenAccountIds             []string
+	HTTPProxy                       *string
+	HTTPSProxy                      *string
+	IgnoreTagsConfig                *tftags.IgnoreConfig
+	Insecure                        bool
+	MaxRetries                      int
+	NoProxy                         string
+	PermissionSimulationConfig      *PermissionSimulationConfig
+	Profile                         string
+	Region                          string
+	RetryMode                       aws.RetryMode
+	RolesAnywhere                   *RolesAnywhereConfig
+	S3UsePathStyle                  bool
+	S3USEast1RegionalEndpoint       string
+	SecretKey                       string
+	ServiceRetryConfigs             []ServiceRetryConfig
+	SharedConfigFiles               []string
+	SharedCredentialsFiles          []string
+	SkipCredsValidation             bool
+	SkipRegionValidation            bool
+	SkipRequestingAccountId         bool
+	STSRegion                       string
+	SuppressDebugLog                bool
+	TerraformVersion                string
+	Token                           string
+	TokenBucketRateLimiterCapacity  int
+	UseDualStackEndpoint            bool
+	UseFIPSEndpoint                 bool
+}
+
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
+// PermissionSimulationConfig holds the settings for simulating the IAM permissions required by planned resource changes.
+type PermissionSimulationConfig struct {
+	Enabled      bool
+	PrincipalARN string
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -70,9 +90,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -136,6 +153,40 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// IAM Roles Anywhere credentials are used as the base credentials. The first set of credentials is
+	// passed to GetAwsConfig and the cached provider then takes over so that the credentials are refreshed.
+	// Any roles are assumed afterwards so that they are re-assumed using the refreshed credentials.
+	var rolesAnywhereCredentials *aws.CredentialsCache
+	if v := c.RolesAnywhere; v != nil {
+		config := *v
+		if config.Endpoint == "" {
+			config.Endpoint = c.Endpoints[names.RolesAnywhere]
+		}
+
+		tflog.Debug(ctx, "Retrieving credentials from IAM Roles Anywhere", map[string]any{
+			"tf_aws.roles_anywhere.profile_arn":      config.ProfileARN,
+			"tf_aws.roles_anywhere.role_arn":         config.RoleARN,
+			"tf_aws.roles_anywhere.trust_anchor_arn": config.TrustAnchorARN,
+		})
+		provider, err := newRolesAnywhereCredentialsProvider(config, client.HTTPClient(ctx))
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring IAM Roles Anywhere: %s", err)
+		}
+
+		rolesAnywhereCredentials = aws.NewCredentialsCache(provider)
+		credentials, err := rolesAnywhereCredentials.Retrieve(ctx)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving IAM Roles Anywhere credentials: %s", err)
+		}
+
+		awsbaseConfig.AccessKey = credentials.AccessKeyID
+		awsbaseConfig.SecretKey = credentials.SecretAccessKey
+		awsbaseConfig.Token = credentials.SessionToken
+		awsbaseConfig.AssumeRole = nil
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -156,6 +207,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if rolesAnywhereCredentials != nil && c.AssumeRoleWithWebIdentity == nil {
+		cfg.Credentials = rolesAnywhereCredentials
+
+		if len(c.AssumeRole) > 0 {
+			credentials, err := assumeRoleCredentialsProvider(ctx, cfg, c.AssumeRole, c.Endpoints[names.STS], c.STSRegion)
+
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			cfg.Credentials = credentials
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -180,6 +245,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.AuditLogPath != "" {
+		tflog.Debug(ctx, "Enabling AWS API call audit logging", map[string]any{
+			"audit_log_path": c.AuditLogPath,
+		})
+		auditLogger, err := newAuditLogger(c.AuditLogPath)
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening audit log (%s): %s", c.AuditLogPath, err)
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, auditLogger.apiOptions())
+		session.Handlers.Complete.PushBackNamed(auditLogger.completeHandler())
+		client.auditLogger = auditLogger
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partitionID, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -209,7 +289,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTagsExcludeResourceTypes = c.DefaultTagsExcludeResourceTypes
+	client.defaultTagsOverrideRules = c.DefaultTagsOverrideRules
+	client.defaultTimeoutsConfig = c.DefaultTimeoutsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.maxRetries = c.MaxRetries
+	client.permissionSimulationConfig = c.PermissionSimulationConfig
 	client.Region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
@@ -222,11 +307,69 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceRetryConfigs = c.ServiceRetryConfigs
 	client.stsRegion = c.STSRegion
+	client.tokenBucketRateLimiterCapacity = c.TokenBucketRateLimiterCapacity
 
 	return client, diags
 }
 
+// assumeRoleCredentialsProvider returns credentials for the chain of IAM roles, each role being assumed using
+// the credentials of the previous one and the first using the credentials in the specified AWS configuration.
+// Unlike role assumption by GetAwsConfig, the base credentials provider is retained so that the first role is
+// re-assumed using refreshed base credentials once its session expires.
+func assumeRoleCredentialsProvider(ctx context.Context, cfg aws.Config, assumeRoles []awsbase.AssumeRole, stsEndpoint, stsRegion string) (aws.CredentialsProvider, error) {
+	for i, ar := range assumeRoles {
+		if ar.RoleARN == "" {
+			return nil, fmt.Errorf("IAM Role ARN not set in assume role %d of %d", i+1, len(assumeRoles))
+		}
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.index":        i,
+			"tf_aws.assume_role.role_arn":     ar.RoleARN,
+			"tf_aws.assume_role.session_name": ar.SessionName,
+		})
+
+		conn := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+		credentials := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(conn, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.Duration = ar.Duration
+			o.RoleSessionName = ar.SessionName
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+
+			if ar.ExternalID != "" {
+				o.ExternalID = aws.String(ar.ExternalID)
+			}
+			if ar.Policy != "" {
+				o.Policy = aws.String(ar.Policy)
+			}
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, awstypes.PolicyDescriptorType{Arn: aws.String(v)})
+			}
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws.String(ar.SourceIdentity)
+			}
+			for k, v := range ar.Tags {
+				o.Tags = append(o.Tags, awstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+		}))
+
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		cfg.Credentials = credentials
+	}
+
+	return cfg.Credentials, nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
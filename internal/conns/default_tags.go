@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sort"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// DefaultTagsOverrideRule holds provider-level overrides of the default tags of the matching resource types.
+type DefaultTagsOverrideRule struct {
+	ExcludeKeys   tftags.KeyValueTags // Default tag keys not applied to the matching resource types.
+	ResourceTypes []string            // Resource type names. A trailing "*" matches any resource type with that prefix.
+	Tags          tftags.KeyValueTags // Additional default tags applied to the matching resource types.
+}
+
+// DefaultTagsConfig returns the provider-configured default tags.
+// If the Context carries a resource type, the provider's exclusions and override rules for that resource type are applied.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return resolveDefaultTags(c.defaultTagsConfig, c.defaultTagsExcludeResourceTypes, c.defaultTagsOverrideRules, inContext.TypeName)
+	}
+
+	return c.defaultTagsConfig
+}
+
+// resolveDefaultTags returns the default tags for the specified resource type.
+// Excluded resource types have no default tags. Otherwise the matching override rules are applied
+// from least to most specific and, when equally specific, in configuration order.
+func resolveDefaultTags(config *tftags.DefaultConfig, excludeResourceTypes []string, rules []DefaultTagsOverrideRule, typeName string) *tftags.DefaultConfig {
+	if typeName == "" || (len(excludeResourceTypes) == 0 && len(rules) == 0) {
+		return config
+	}
+
+	if matchResourceType(excludeResourceTypes, typeName) >= 0 {
+		return nil
+	}
+
+	type match struct {
+		rule        DefaultTagsOverrideRule
+		specificity int
+	}
+	var matches []match
+
+	for _, rule := range rules {
+		if specificity := matchResourceType(rule.ResourceTypes, typeName); specificity >= 0 {
+			matches = append(matches, match{rule: rule, specificity: specificity})
+		}
+	}
+
+	if len(matches) == 0 {
+		return config
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].specificity < matches[j].specificity
+	})
+
+	tags := config.GetTags()
+	for _, v := range matches {
+		tags = tags.Ignore(v.rule.ExcludeKeys).Merge(v.rule.Tags)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &tftags.DefaultConfig{
+		Tags: tags,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestResolveDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"Environment": "test",
+			"Owner":       "team",
+		}),
+	}
+	excludeResourceTypes := []string{"aws_autoscaling_*", "aws_elastic_beanstalk_application_version"}
+	rules := []DefaultTagsOverrideRule{
+		{
+			ResourceTypes: []string{"aws_elastic_beanstalk_*"},
+			ExcludeKeys:   tftags.New(ctx, []string{"Owner"}),
+		},
+		{
+			ResourceTypes: []string{"aws_elastic_beanstalk_environment"},
+			Tags:          tftags.New(ctx, map[string]string{"Environment": "production"}),
+		},
+		{
+			ResourceTypes: []string{"aws_s3_*"},
+			ExcludeKeys:   tftags.New(ctx, []string{"Environment", "Owner"}),
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName string
+		expected map[string]string
+	}{
+		"no resource type": {
+			expected: map[string]string{"Environment": "test", "Owner": "team"},
+		},
+		"no match": {
+			typeName: "aws_vpc",
+			expected: map[string]string{"Environment": "test", "Owner": "team"},
+		},
+		"excluded prefix": {
+			typeName: "aws_autoscaling_group",
+		},
+		"excluded exact": {
+			typeName: "aws_elastic_beanstalk_application_version",
+		},
+		"exclude keys": {
+			typeName: "aws_elastic_beanstalk_application",
+			expected: map[string]string{"Environment": "test"},
+		},
+		"more specific rule applied last": {
+			typeName: "aws_elastic_beanstalk_environment",
+			expected: map[string]string{"Environment": "production"},
+		},
+		"all keys excluded": {
+			typeName: "aws_s3_bucket",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]string
+			if v := resolveDefaultTags(config, excludeResourceTypes, rules, testCase.typeName); v != nil {
+				got = v.Tags.Map()
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// PlannedActions returns the IAM actions called when a resource's planned change is applied.
+// prior and planned are the resource's prior and planned states; either is null when the resource is created or destroyed.
+func PlannedActions(permissions types.ServicePackageResourcePermissions, prior, planned tftypes.Value, requiresReplace bool) []string {
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		return nil
+	case prior.IsNull():
+		return permissions.Create
+	case planned.IsNull():
+		return permissions.Delete
+	case requiresReplace:
+		actions := slices.Concat(permissions.Delete, permissions.Create)
+		slices.Sort(actions)
+		return slices.Compact(actions)
+	case !planned.Equal(prior):
+		return permissions.Update
+	default:
+		return nil
+	}
+}
+
+// HasPlannedChange returns whether a resource's planned state differs from its prior state.
+func HasPlannedChange(prior, planned tftypes.Value) bool {
+	return !planned.Equal(prior)
+}
+
+// PermissionsNotDeclared records a planned change to a resource that does not declare the IAM actions it calls,
+// and whose permissions therefore cannot be simulated.
+// A single warning covering all such resources is returned for the first one; the resource types are logged.
+func (c *AWSClient) PermissionsNotDeclared(ctx context.Context, typeName string) (string, string) {
+	tflog.Warn(ctx, "IAM permissions not simulated, resource does not declare the IAM actions it calls", map[string]any{
+		"resource_type": typeName,
+	})
+
+	c.permissionSimulationLock.Lock()
+	defer c.permissionSimulationLock.Unlock()
+
+	if c.permissionsNotDeclaredReported {
+		return "", ""
+	}
+	c.permissionsNotDeclaredReported = true
+
+	return "IAM permissions not simulated", fmt.Sprintf("Some resources with planned changes, including %s, do not declare the IAM actions they call, so the permissions required by their planned changes were not simulated. "+
+		"Only resources that declare their IAM actions are covered by permission simulation. Each resource type that was not simulated is logged as a warning.", typeName)
+}
+
+// SimulatePermissions simulates whether the principal configured for permission simulation is allowed to call the specified IAM actions.
+// If any action is not allowed, or the simulation cannot be run, the summary and detail of a warning are returned.
+func (c *AWSClient) SimulatePermissions(ctx context.Context, typeName string, actions []string) (string, string) {
+	if len(actions) == 0 {
+		return "", ""
+	}
+
+	principalARN, err := c.permissionSimulationPrincipalARN(ctx)
+
+	if err != nil {
+		return "Unable to simulate IAM permissions", fmt.Sprintf("Resolving the principal for %s: %s", typeName, err)
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     actions,
+		PolicySourceArn: aws.String(principalARN),
+	}
+
+	var denied []string
+	pages := iam.NewSimulatePrincipalPolicyPaginator(c.IAMClient(ctx), input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return "Unable to simulate IAM permissions", fmt.Sprintf("Simulating IAM permissions of %s for %s: %s", principalARN, typeName, err)
+		}
+
+		for _, v := range page.EvaluationResults {
+			if v.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, fmt.Sprintf("%s (%s)", aws.ToString(v.EvalActionName), v.EvalDecision))
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "simulated IAM permissions", map[string]any{
+		"principal_arn": principalARN,
+		"actions":       actions,
+		"denied":        denied,
+	})
+
+	if len(denied) == 0 {
+		return "", ""
+	}
+
+	return "Missing IAM permissions", fmt.Sprintf("The planned change to %s calls actions that %s is not allowed to perform:\n\n  %s\n\n"+
+		"The simulation does not take resource policies, service control policies or condition keys into account, so the apply may still succeed.",
+		typeName, principalARN, strings.Join(denied, "\n  "))
+}
+
+// permissionSimulationPrincipalARN returns the ARN of the IAM user or role whose permissions are simulated.
+// The principal of the provider's credentials is resolved once and cached.
+func (c *AWSClient) permissionSimulationPrincipalARN(ctx context.Context) (string, error) {
+	if v := c.permissionSimulationConfig; v != nil && v.PrincipalARN != "" {
+		return v.PrincipalARN, nil
+	}
+
+	c.permissionSimulationLock.Lock()
+	defer c.permissionSimulationLock.Unlock()
+
+	if v := c.permissionSimulationPrincipal; v != "" {
+		return v, nil
+	}
+
+	output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", err
+	}
+
+	principalARN, err := iamPrincipalARN(aws.ToString(output.Arn))
+
+	if err != nil {
+		return "", err
+	}
+
+	c.permissionSimulationPrincipal = principalARN
+
+	return principalARN, nil
+}
+
+// iamPrincipalARN converts an STS assumed role ARN into the ARN of the underlying IAM role.
+// Role paths are not present in assumed role ARNs; set `principal_arn` for roles with a path.
+func iamPrincipalARN(s string) (string, error) {
+	parsed, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	switch parts := strings.Split(parsed.Resource, "/"); {
+	case parsed.Service == "iam":
+		return s, nil
+	case parsed.Service == "sts" && parts[0] == "assumed-role" && len(parts) == 3:
+		parsed.Service = "iam"
+		parsed.Resource = "role/" + parts[1]
+		return parsed.String(), nil
+	default:
+		return "", fmt.Errorf("permissions of %q cannot be simulated; set principal_arn", s)
+	}
+}
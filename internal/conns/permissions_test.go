@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestPlannedActions(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	null := tftypes.NewValue(objectType, nil)
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+	permissions := types.ServicePackageResourcePermissions{
+		Create: []string{"svc:Create", "svc:Describe"},
+		Update: []string{"svc:Update"},
+		Delete: []string{"svc:Delete", "svc:Describe"},
+	}
+
+	testCases := map[string]struct {
+		prior, planned  tftypes.Value
+		requiresReplace bool
+		expected        []string
+	}{
+		"create": {
+			prior:    null,
+			planned:  value("a"),
+			expected: []string{"svc:Create", "svc:Describe"},
+		},
+		"update": {
+			prior:    value("a"),
+			planned:  value("b"),
+			expected: []string{"svc:Update"},
+		},
+		"replace": {
+			prior:           value("a"),
+			planned:         value("b"),
+			requiresReplace: true,
+			expected:        []string{"svc:Create", "svc:Delete", "svc:Describe"},
+		},
+		"delete": {
+			prior:    value("a"),
+			planned:  null,
+			expected: []string{"svc:Delete", "svc:Describe"},
+		},
+		"no change": {
+			prior:   value("a"),
+			planned: value("a"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := PlannedActions(permissions, testCase.prior, testCase.planned, testCase.requiresReplace)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHasPlannedChange(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	null := tftypes.NewValue(objectType, nil)
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+
+	testCases := map[string]struct {
+		prior, planned tftypes.Value
+		expected       bool
+	}{
+		"create": {
+			prior:    null,
+			planned:  value("a"),
+			expected: true,
+		},
+		"update": {
+			prior:    value("a"),
+			planned:  value("b"),
+			expected: true,
+		},
+		"delete": {
+			prior:    value("a"),
+			planned:  null,
+			expected: true,
+		},
+		"no change": {
+			prior:   value("a"),
+			planned: value("a"),
+		},
+		"null": {
+			prior:   null,
+			planned: null,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := HasPlannedChange(testCase.prior, testCase.planned), testCase.expected; got != want {
+				t.Errorf("HasPlannedChange() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestPermissionsNotDeclared(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{}
+
+	if summary, detail := c.PermissionsNotDeclared(ctx, "aws_vpc"); summary == "" || !strings.Contains(detail, "aws_vpc") {
+		t.Errorf("expected a warning for the first resource, got %q: %q", summary, detail)
+	}
+
+	for _, typeName := range []string{"aws_subnet", "aws_vpc"} {
+		if summary, _ := c.PermissionsNotDeclared(ctx, typeName); summary != "" {
+			t.Errorf("expected no further warning for %s, got %q", typeName, summary)
+		}
+	}
+}
+
+func TestIAMPrincipalARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		"invalid ARN": {
+			input:         "not-an-arn",
+			expectedError: true,
+		},
+		"IAM user": {
+			input:    "arn:aws:iam::123456789012:user/Bob", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:user/Bob", //lintignore:AWSAT005
+		},
+		"IAM role": {
+			input:    "arn:aws:iam::123456789012:role/path/S3Access", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/path/S3Access", //lintignore:AWSAT005
+		},
+		"assumed role": {
+			input:    "arn:aws:sts::123456789012:assumed-role/Accounting-Role/Mary", //lintignore:AWSAT005
+			expected: "arn:aws:iam::123456789012:role/Accounting-Role",              //lintignore:AWSAT005
+		},
+		"assumed role in other partition": {
+			input:    "arn:aws-us-gov:sts::123456789012:assumed-role/Accounting-Role/Mary", //lintignore:AWSAT005
+			expected: "arn:aws-us-gov:iam::123456789012:role/Accounting-Role",              //lintignore:AWSAT005
+		},
+		"federated user": {
+			input:         "arn:aws:sts::123456789012:federated-user/Bob", //lintignore:AWSAT005
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iamPrincipalARN(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("iamPrincipalARN(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("iamPrincipalARN(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
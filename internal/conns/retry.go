@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// ServiceRetryConfig holds provider-level overrides of the API retry behavior of the matching services.
+type ServiceRetryConfig struct {
+	Services   []string // Service package names.
+	MaxRetries int
+	RetryMode  aws.RetryMode
+}
+
+// resolveServiceRetryConfig resolves each setting independently.
+// When a service matches more than one configuration block, a later block takes precedence over an earlier one.
+func resolveServiceRetryConfig(configs []ServiceRetryConfig, servicePackageName string) (ServiceRetryConfig, bool) {
+	var result ServiceRetryConfig
+	var found bool
+
+	for _, config := range configs {
+		if !slices.Contains(config.Services, servicePackageName) {
+			continue
+		}
+
+		found = true
+
+		if config.MaxRetries > 0 {
+			result.MaxRetries = config.MaxRetries
+		}
+		if config.RetryMode != "" {
+			result.RetryMode = config.RetryMode
+		}
+	}
+
+	if found {
+		result.Services = []string{servicePackageName}
+	}
+
+	return result, found
+}
+
+// awsConfigForService returns the AWS SDK for Go v2 configuration used to create the specified service's API client.
+// The provider-wide configuration is returned unless the service's retry behavior is overridden.
+func (c *AWSClient) awsConfigForService(servicePackageName string) *aws.Config {
+	config, ok := resolveServiceRetryConfig(c.serviceRetryConfigs, servicePackageName)
+
+	if !ok {
+		return c.awsConfig
+	}
+
+	cfg := c.awsConfig.Copy()
+
+	maxRetries := c.maxRetries
+	if config.MaxRetries > 0 {
+		maxRetries = config.MaxRetries
+	}
+
+	if config.RetryMode != "" {
+		// A new retryer is required to change the mode. Preserve the provider's backoff and rate limiting.
+		cfg.RetryMode = config.RetryMode
+		cfg.Retryer = newRetryer(config.RetryMode, maxRetries, c.tokenBucketRateLimiterCapacity)
+	}
+
+	if config.MaxRetries > 0 {
+		cfg.RetryMaxAttempts = maxRetries
+	}
+
+	return &cfg
+}
+
+func newRetryer(mode aws.RetryMode, maxAttempts, rateLimiterCapacity int) func() aws.Retryer {
+	standardOptions := func(o *retry.StandardOptions) {
+		o.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+		o.MaxAttempts = maxAttempts
+		o.MaxBackoff = maxBackoff
+		if rateLimiterCapacity > 0 {
+			o.RateLimiter = ratelimit.NewTokenRateLimit(uint(rateLimiterCapacity))
+		}
+	}
+
+	return func() aws.Retryer {
+		if mode == aws.RetryModeAdaptive {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standardOptions)
+			})
+		}
+
+		return retry.NewStandard(standardOptions)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveServiceRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	configs := []ServiceRetryConfig{
+		{
+			Services:   []string{"lambda", "s3"},
+			MaxRetries: 10,
+			RetryMode:  aws.RetryModeAdaptive,
+		},
+		{
+			Services:   []string{"lambda"},
+			MaxRetries: 50,
+		},
+		{
+			Services:  []string{"s3"},
+			RetryMode: aws.RetryModeStandard,
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		expected           ServiceRetryConfig
+		expectedFound      bool
+	}{
+		"no match": {
+			servicePackageName: "ec2",
+		},
+		"later max retries": {
+			servicePackageName: "lambda",
+			expected: ServiceRetryConfig{
+				Services:   []string{"lambda"},
+				MaxRetries: 50,
+				RetryMode:  aws.RetryModeAdaptive,
+			},
+			expectedFound: true,
+		},
+		"later retry mode": {
+			servicePackageName: "s3",
+			expected: ServiceRetryConfig{
+				Services:   []string{"s3"},
+				MaxRetries: 10,
+				RetryMode:  aws.RetryModeStandard,
+			},
+			expectedFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found := resolveServiceRetryConfig(configs, testCase.servicePackageName)
+
+			if found != testCase.expectedFound {
+				t.Errorf("found %t, want %t", found, testCase.expectedFound)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAWSConfigForService(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		awsConfig:  &aws.Config{},
+		maxRetries: 25,
+		serviceRetryConfigs: []ServiceRetryConfig{
+			{
+				Services:  []string{"lambda"},
+				RetryMode: aws.RetryModeAdaptive,
+			},
+			{
+				Services:   []string{"s3"},
+				MaxRetries: 5,
+			},
+		},
+	}
+
+	if got, want := client.awsConfigForService("ec2"), client.awsConfig; got != want {
+		t.Errorf("ec2: got %p, want provider configuration %p", got, want)
+	}
+
+	cfg := client.awsConfigForService("lambda")
+	if got, want := cfg.RetryMode, aws.RetryModeAdaptive; got != want {
+		t.Errorf("lambda: RetryMode got %q, want %q", got, want)
+	}
+	if cfg.Retryer == nil {
+		t.Fatal("lambda: Retryer is nil")
+	}
+	if got, want := cfg.Retryer().MaxAttempts(), 25; got != want {
+		t.Errorf("lambda: MaxAttempts got %d, want %d", got, want)
+	}
+
+	cfg = client.awsConfigForService("s3")
+	if got, want := cfg.RetryMaxAttempts, 5; got != want {
+		t.Errorf("s3: RetryMaxAttempts got %d, want %d", got, want)
+	}
+	if cfg.Retryer != nil {
+		t.Error("s3: Retryer unexpectedly replaced")
+	}
+}
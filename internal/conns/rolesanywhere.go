@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// RolesAnywhereConfig holds the settings for sourcing credentials from IAM Roles Anywhere.
+type RolesAnywhereConfig struct {
+	CertificatePath string
+	Duration        time.Duration
+	Endpoint        string
+	PrivateKeyPath  string
+	ProfileARN      string
+	RoleARN         string
+	TrustAnchorARN  string
+}
+
+const (
+	rolesAnywhereSigningName           = "rolesanywhere"
+	rolesAnywhereAlgorithmRSA          = "AWS4-X509-RSA-SHA256"
+	rolesAnywhereAlgorithmECDSA        = "AWS4-X509-ECDSA-SHA256"
+	rolesAnywhereTimeFormat            = "20060102T150405Z"
+	rolesAnywhereShortTimeFormat       = "20060102"
+	rolesAnywhereDefaultSessionTimeout = 1 * time.Hour
+)
+
+// rolesAnywhereCredentialsProvider retrieves temporary credentials by calling the IAM Roles Anywhere CreateSession API.
+// Requests are authenticated using the X.509 end-entity certificate and its private key.
+type rolesAnywhereCredentialsProvider struct {
+	certificate *x509.Certificate
+	chain       []*x509.Certificate
+	config      RolesAnywhereConfig
+	endpoint    string
+	httpClient  *http.Client
+	privateKey  crypto.Signer
+	region      string
+}
+
+func newRolesAnywhereCredentialsProvider(config RolesAnywhereConfig, httpClient *http.Client) (*rolesAnywhereCredentialsProvider, error) {
+	trustAnchorARN, err := arn.Parse(config.TrustAnchorARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing trust anchor ARN (%s): %w", config.TrustAnchorARN, err)
+	}
+
+	certificates, err := readCertificates(config.CertificatePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := readPrivateKey(config.PrivateKeyPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	region := trustAnchorARN.Region
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.%s", rolesAnywhereSigningName, region, names.PartitionForRegion(region).DNSSuffix())
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+
+	return &rolesAnywhereCredentialsProvider{
+		certificate: certificates[0],
+		chain:       certificates[1:],
+		config:      config,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		httpClient:  httpClient,
+		privateKey:  privateKey,
+		region:      region,
+	}, nil
+}
+
+type rolesAnywhereCreateSessionInput struct {
+	DurationSeconds int32  `json:"durationSeconds"`
+	ProfileARN      string `json:"profileArn"`
+	RoleARN         string `json:"roleArn"`
+	TrustAnchorARN  string `json:"trustAnchorArn"`
+}
+
+type rolesAnywhereCreateSessionOutput struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string    `json:"accessKeyId"`
+			Expiration      time.Time `json:"expiration"`
+			SecretAccessKey string    `json:"secretAccessKey"`
+			SessionToken    string    `json:"sessionToken"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+}
+
+// Retrieve implements aws.CredentialsProvider.
+func (p *rolesAnywhereCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	duration := p.config.Duration
+	if duration == 0 {
+		duration = rolesAnywhereDefaultSessionTimeout
+	}
+
+	body, err := json.Marshal(rolesAnywhereCreateSessionInput{
+		DurationSeconds: int32(duration.Seconds()),
+		ProfileARN:      p.config.ProfileARN,
+		RoleARN:         p.config.RoleARN,
+		TrustAnchorARN:  p.config.TrustAnchorARN,
+	})
+
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/sessions", bytes.NewReader(body))
+
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	if err := p.sign(req, body, time.Now()); err != nil {
+		return aws.Credentials{}, fmt.Errorf("signing IAM Roles Anywhere CreateSession request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: %s: %s", resp.Status, string(respBody))
+	}
+
+	var output rolesAnywhereCreateSessionOutput
+
+	if err := json.Unmarshal(respBody, &output); err != nil {
+		return aws.Credentials{}, fmt.Errorf("parsing IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	if len(output.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New("IAM Roles Anywhere CreateSession returned no credentials")
+	}
+
+	credentials := output.CredentialSet[0].Credentials
+
+	return aws.Credentials{
+		AccessKeyID:     credentials.AccessKeyID,
+		CanExpire:       true,
+		Expires:         credentials.Expiration,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+		Source:          "IAMRolesAnywhere",
+	}, nil
+}
+
+// sign signs the request using the Signature Version 4 X.509 variant used by IAM Roles Anywhere.
+func (p *rolesAnywhereCredentialsProvider) sign(req *http.Request, body []byte, now time.Time) error {
+	var algorithm string
+	switch p.privateKey.(type) {
+	case *rsa.PrivateKey:
+		algorithm = rolesAnywhereAlgorithmRSA
+	case *ecdsa.PrivateKey:
+		algorithm = rolesAnywhereAlgorithmECDSA
+	default:
+		return fmt.Errorf("unsupported private key type: %T", p.privateKey)
+	}
+
+	now = now.UTC()
+	amzDate := now.Format(rolesAnywhereTimeFormat)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-X509", base64.StdEncoding.EncodeToString(p.certificate.Raw))
+	if len(p.chain) > 0 {
+		chain := make([]string, len(p.chain))
+		for i, v := range p.chain {
+			chain[i] = base64.StdEncoding.EncodeToString(v.Raw)
+		}
+		req.Header.Set("X-Amz-X509-Chain", strings.Join(chain, ","))
+	}
+
+	var headerNames []string
+	for k := range req.Header {
+		headerNames = append(headerNames, strings.ToLower(k))
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range headerNames {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", k, strings.TrimSpace(req.Header.Get(k)))
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	credentialScope := strings.Join([]string{now.Format(rolesAnywhereShortTimeFormat), p.region, rolesAnywhereSigningName, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		algorithm,
+		amzDate,
+		credentialScope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := p.privateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, p.certificate.SerialNumber.String(), credentialScope, signedHeaders, hex.EncodeToString(signature)))
+
+	return nil
+}
+
+// readCertificates reads the PEM-encoded end-entity certificate, followed by any intermediate certificates, from the specified file.
+func readCertificates(path string) ([]*x509.Certificate, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading certificate (%s): %w", path, err)
+	}
+
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate (%s): %w", path, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no PEM-encoded certificate found in %s", path)
+	}
+
+	return certificates, nil
+}
+
+// readPrivateKey reads the unencrypted PEM-encoded RSA or EC private key from the specified file.
+func readPrivateKey(path string) (crypto.Signer, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading private key (%s): %w", path, err)
+	}
+
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+
+		if block == nil {
+			break
+		}
+
+		var key any
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key (%s): %w", path, err)
+		}
+
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case *ecdsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type (%s): %T", path, key)
+		}
+	}
+
+	return nil, fmt.Errorf("no PEM-encoded private key found in %s", path)
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRolesAnywhereCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		newKey        func(t *testing.T) (crypto.Signer, *pem.Block)
+		wantAlgorithm string
+	}{
+		"ECDSA": {
+			newKey: func(t *testing.T) (crypto.Signer, *pem.Block) {
+				key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := x509.MarshalECPrivateKey(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return key, &pem.Block{Type: "EC PRIVATE KEY", Bytes: b}
+			},
+			wantAlgorithm: rolesAnywhereAlgorithmECDSA,
+		},
+		"RSA": {
+			newKey: func(t *testing.T) (crypto.Signer, *pem.Block) {
+				key, err := rsa.GenerateKey(rand.Reader, 2048)
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := x509.MarshalPKCS8PrivateKey(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return key, &pem.Block{Type: "PRIVATE KEY", Bytes: b}
+			},
+			wantAlgorithm: rolesAnywhereAlgorithmRSA,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			key, keyBlock := testCase.newKey(t)
+			certificate, certificatePath, privateKeyPath := writeRolesAnywhereCertificate(t, key, keyBlock)
+
+			config := RolesAnywhereConfig{
+				CertificatePath: certificatePath,
+				Duration:        30 * time.Minute,
+				PrivateKeyPath:  privateKeyPath,
+				ProfileARN:      "arn:aws:rolesanywhere:us-west-2:123456789012:profile/abcd",      //lintignore:AWSAT003,AWSAT005
+				RoleARN:         "arn:aws:iam::123456789012:role/test",                            //lintignore:AWSAT005
+				TrustAnchorARN:  "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/efgh", //lintignore:AWSAT003,AWSAT005
+			}
+			wantInput := rolesAnywhereCreateSessionInput{
+				DurationSeconds: 1800,
+				ProfileARN:      config.ProfileARN,
+				RoleARN:         config.RoleARN,
+				TrustAnchorARN:  config.TrustAnchorARN,
+			}
+			expiration := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+			authorization := regexp.MustCompile(`^` + testCase.wantAlgorithm + ` Credential=123456789/\d{8}/us-west-2/rolesanywhere/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-x509, Signature=[0-9a-f]+$`) //lintignore:AWSAT003
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got, want := r.URL.Path, "/sessions"; got != want {
+					t.Errorf("got path %q, want %q", got, want)
+				}
+				if got := r.Header.Get("Authorization"); !authorization.MatchString(got) {
+					t.Errorf("unexpected Authorization header: %q", got)
+				}
+				if got, want := r.Header.Get("X-Amz-X509"), base64.StdEncoding.EncodeToString(certificate); got != want {
+					t.Errorf("got X-Amz-X509 header %q, want %q", got, want)
+				}
+
+				var input rolesAnywhereCreateSessionInput
+				if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+					t.Error(err)
+				}
+				if got, want := input, wantInput; got != want {
+					t.Errorf("got input %+v, want %+v", got, want)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"credentialSet":[{"credentials":{"accessKeyId":"AKID","secretAccessKey":"SECRET","sessionToken":"TOKEN","expiration":"2030-01-01T00:00:00Z"}}]}`)) //nolint:errcheck // Test server.
+			}))
+			defer server.Close()
+
+			config.Endpoint = server.URL
+
+			provider, err := newRolesAnywhereCredentialsProvider(config, server.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			credentials, err := provider.Retrieve(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := credentials.AccessKeyID, "AKID"; got != want {
+				t.Errorf("got AccessKeyID %q, want %q", got, want)
+			}
+			if got, want := credentials.SecretAccessKey, "SECRET"; got != want {
+				t.Errorf("got SecretAccessKey %q, want %q", got, want)
+			}
+			if got, want := credentials.SessionToken, "TOKEN"; got != want {
+				t.Errorf("got SessionToken %q, want %q", got, want)
+			}
+			if got, want := credentials.Expires, expiration; !got.Equal(want) {
+				t.Errorf("got Expires %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigureProviderRolesAnywhereAssumeRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, certificatePath, privateKeyPath := writeRolesAnywhereCertificate(t, key, &pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+
+	var (
+		lock             sync.Mutex
+		sessions         int
+		baseAccessKeyID  string
+		assumeRoleCalls  int
+		signingAccessKey = regexp.MustCompile(`Credential=([^/]+)/`)
+	)
+	// Both sets of credentials are already expired, so each retrieval of the assumed role credentials
+	// calls IAM Roles Anywhere for new base credentials and then re-assumes the role using them.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		switch r.URL.Path {
+		case "/sessions":
+			sessions++
+			baseAccessKeyID = fmt.Sprintf("BASE%d", sessions)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"credentialSet":[{"credentials":{"accessKeyId":%q,"secretAccessKey":"SECRET","sessionToken":"TOKEN","expiration":"2000-01-01T00:00:00Z"}}]}`, baseAccessKeyID)
+		default:
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+			}
+			if got, want := r.PostForm.Get("Action"), "AssumeRole"; got != want {
+				t.Errorf("got STS action %q, want %q", got, want)
+			}
+			if got, want := r.PostForm.Get("RoleArn"), "arn:aws:iam::123456789012:role/assumed"; got != want { //lintignore:AWSAT005
+				t.Errorf("got role ARN %q, want %q", got, want)
+			}
+			if m := signingAccessKey.FindStringSubmatch(r.Header.Get("Authorization")); m == nil || m[1] != baseAccessKeyID {
+				t.Errorf("role assumed using stale base credentials: got Authorization header %q, want access key %q", r.Header.Get("Authorization"), baseAccessKeyID)
+			}
+			assumeRoleCalls++
+
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/assumed/session</Arn>
+      <AssumedRoleId>AROA:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASSUMED%d</AccessKeyId>
+      <SecretAccessKey>SECRET</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>2000-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, assumeRoleCalls)
+		}
+	}))
+	defer server.Close()
+
+	config := Config{
+		AssumeRole: []awsbase.AssumeRole{{
+			RoleARN:     "arn:aws:iam::123456789012:role/assumed", //lintignore:AWSAT005
+			SessionName: "session",
+		}},
+		Endpoints: map[string]string{
+			names.RolesAnywhere: server.URL,
+			names.STS:           server.URL,
+		},
+		Region: "us-west-2",
+		RolesAnywhere: &RolesAnywhereConfig{
+			CertificatePath: certificatePath,
+			PrivateKeyPath:  privateKeyPath,
+			ProfileARN:      "arn:aws:rolesanywhere:us-west-2:123456789012:profile/abcd",      //lintignore:AWSAT003,AWSAT005
+			RoleARN:         "arn:aws:iam::123456789012:role/test",                            //lintignore:AWSAT005
+			TrustAnchorARN:  "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/efgh", //lintignore:AWSAT003,AWSAT005
+		},
+		SkipCredsValidation:     true,
+		SkipRequestingAccountId: true,
+	}
+
+	client, diags := config.ConfigureProvider(ctx, &AWSClient{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for i := 0; i < 2; i++ {
+		credentials, err := client.AwsConfig(ctx).Credentials.Retrieve(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lock.Lock()
+		want := fmt.Sprintf("ASSUMED%d", assumeRoleCalls)
+		lock.Unlock()
+
+		if got := credentials.AccessKeyID; got != want {
+			t.Errorf("got AccessKeyID %q, want %q", got, want)
+		}
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if got, want := assumeRoleCalls, 3; got != want {
+		t.Errorf("got %d AssumeRole calls, want %d", got, want)
+	}
+}
+
+func writeRolesAnywhereCertificate(t *testing.T, key crypto.Signer, keyBlock *pem.Block) ([]byte, string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(123456789),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-1 * time.Hour),
+		NotAfter:     time.Now().Add(1 * time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificatePath := filepath.Join(dir, "certificate.pem")
+	if err := os.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	privateKeyPath := filepath.Join(dir, "private_key.pem")
+	if err := os.WriteFile(privateKeyPath, pem.EncodeToMemory(keyBlock), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certificate, certificatePath, privateKeyPath
+}
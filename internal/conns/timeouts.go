@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// DefaultTimeoutsConfig holds provider-level overrides of the default Create, Update and Delete timeouts
+// of the matching resource types.
+type DefaultTimeoutsConfig struct {
+	ResourceTypes []string // Resource type names. A trailing "*" matches any resource type with that prefix.
+	Create        time.Duration
+	Update        time.Duration
+	Delete        time.Duration
+}
+
+// ResourceTimeouts holds the overridden default timeouts for a single resource type.
+// A zero value means that the resource's own default is used.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// DefaultTimeouts returns the provider-configured default timeouts for the specified resource type.
+func (c *AWSClient) DefaultTimeouts(_ context.Context, typeName string) ResourceTimeouts {
+	return resolveDefaultTimeouts(c.defaultTimeoutsConfig, typeName)
+}
+
+// resolveDefaultTimeouts resolves each operation's timeout independently.
+// An exact resource type match takes precedence over a wildcard match, a longer wildcard prefix takes precedence
+// over a shorter one and, when equally specific, a later configuration block takes precedence over an earlier one.
+func resolveDefaultTimeouts(configs []DefaultTimeoutsConfig, typeName string) ResourceTimeouts {
+	var result ResourceTimeouts
+	createSpecificity, updateSpecificity, deleteSpecificity := -1, -1, -1
+
+	for _, config := range configs {
+		specificity := matchResourceType(config.ResourceTypes, typeName)
+
+		if specificity < 0 {
+			continue
+		}
+
+		if config.Create > 0 && specificity >= createSpecificity {
+			result.Create, createSpecificity = config.Create, specificity
+		}
+		if config.Update > 0 && specificity >= updateSpecificity {
+			result.Update, updateSpecificity = config.Update, specificity
+		}
+		if config.Delete > 0 && specificity >= deleteSpecificity {
+			result.Delete, deleteSpecificity = config.Delete, specificity
+		}
+	}
+
+	return result
+}
+
+// matchResourceType returns how specifically the resource type patterns match the resource type name,
+// or -1 if none match.
+func matchResourceType(patterns []string, typeName string) int {
+	result := -1
+
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(typeName, prefix) && len(prefix) > result {
+				result = len(prefix)
+			}
+		} else if pattern == typeName {
+			return math.MaxInt
+		}
+	}
+
+	return result
+}
+
+type (
+	defaultTimeoutsContextKeyType int
+)
+
+var (
+	defaultTimeoutsContextKey defaultTimeoutsContextKeyType
+)
+
+// NewDefaultTimeoutsContext returns a Context that carries a resource's provider-configured default timeouts.
+func NewDefaultTimeoutsContext(ctx context.Context, timeouts ResourceTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey, timeouts)
+}
+
+// DefaultTimeoutsFromContext returns any provider-configured default timeouts carried in the Context.
+func DefaultTimeoutsFromContext(ctx context.Context) (ResourceTimeouts, bool) {
+	v, ok := ctx.Value(defaultTimeoutsContextKey).(ResourceTimeouts)
+	return v, ok
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	configs := []DefaultTimeoutsConfig{
+		{
+			ResourceTypes: []string{"aws_db_*"},
+			Create:        90 * time.Minute,
+			Delete:        60 * time.Minute,
+		},
+		{
+			ResourceTypes: []string{"aws_db_instance"},
+			Create:        120 * time.Minute,
+		},
+		{
+			ResourceTypes: []string{"aws_*"},
+			Create:        10 * time.Minute,
+			Update:        15 * time.Minute,
+		},
+		{
+			ResourceTypes: []string{"aws_db_cluster_*"},
+			Delete:        30 * time.Minute,
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName string
+		expected ResourceTimeouts
+	}{
+		"exact match": {
+			typeName: "aws_db_instance",
+			expected: ResourceTimeouts{Create: 120 * time.Minute, Update: 15 * time.Minute, Delete: 60 * time.Minute},
+		},
+		"longest prefix": {
+			typeName: "aws_db_cluster_snapshot",
+			expected: ResourceTimeouts{Create: 90 * time.Minute, Update: 15 * time.Minute, Delete: 30 * time.Minute},
+		},
+		"prefix": {
+			typeName: "aws_db_subnet_group",
+			expected: ResourceTimeouts{Create: 90 * time.Minute, Update: 15 * time.Minute, Delete: 60 * time.Minute},
+		},
+		"catch-all": {
+			typeName: "aws_vpc",
+			expected: ResourceTimeouts{Create: 10 * time.Minute, Update: 15 * time.Minute},
+		},
+		"no match": {
+			typeName: "awscc_vpc",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resolveDefaultTimeouts(configs, testCase.typeName)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveDefaultTimeouts_laterBlockWins(t *testing.T) {
+	t.Parallel()
+
+	configs := []DefaultTimeoutsConfig{
+		{
+			ResourceTypes: []string{"aws_instance"},
+			Create:        20 * time.Minute,
+		},
+		{
+			ResourceTypes: []string{"aws_instance"},
+			Create:        40 * time.Minute,
+		},
+	}
+
+	got := resolveDefaultTimeouts(configs, "aws_instance")
+	expected := ResourceTimeouts{Create: 40 * time.Minute}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -56,7 +57,7 @@ func ProblemStandardMessage(service, action, resource, id string, gotError error
 func AddError(d *fwdiag.Diagnostics, service, action, resource, id string, gotError error) {
 	d.AddError(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		errs.ErrorDetail(gotError),
 	)
 }
 
@@ -77,6 +78,7 @@ func diagError(service, action, resource, id string, gotError error) diag.Diagno
 	return diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
+		Detail:   errs.AWSErrorDetail(gotError),
 	}
 }
 
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"strings"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+// AWSErrorInfo holds the structured details of an error returned by an AWS API operation.
+// These are the details AWS Support asks for when investigating a failed request.
+type AWSErrorInfo struct {
+	Code      string
+	Message   string
+	Operation string
+	RequestID string
+	Service   string
+}
+
+// serviceRequestIDer is implemented by AWS SDK for Go v2 HTTP response errors.
+type serviceRequestIDer interface {
+	error
+	ServiceRequestID() string
+}
+
+// requestIDer is implemented by AWS SDK for Go v1 request failures.
+type requestIDer interface {
+	error
+	RequestID() string
+}
+
+// AWSErrorInfoFrom returns the structured details of any AWS API error in err's tree.
+// The returned bool is false if err does not wrap an AWS API error.
+func AWSErrorInfoFrom(err error) (AWSErrorInfo, bool) {
+	var info AWSErrorInfo
+
+	if err == nil {
+		return info, false
+	}
+
+	if v, ok := As[*smithy.OperationError](err); ok {
+		info.Operation = v.Operation()
+		info.Service = v.Service()
+	}
+
+	if v, ok := As[smithy.APIError](err); ok {
+		info.Code = v.ErrorCode()
+		info.Message = v.ErrorMessage()
+	}
+
+	if v, ok := As[serviceRequestIDer](err); ok {
+		info.RequestID = v.ServiceRequestID()
+	} else if v, ok := As[requestIDer](err); ok {
+		info.RequestID = v.RequestID()
+	}
+
+	if info == (AWSErrorInfo{}) {
+		return info, false
+	}
+
+	return info, true
+}
+
+// String formats the details one per line, omitting empty values.
+func (i AWSErrorInfo) String() string {
+	var lines []string
+
+	for _, v := range []struct {
+		label, value string
+	}{
+		{"Service", i.Service},
+		{"Operation", i.Operation},
+		{"Error Code", i.Code},
+		{"Message", i.Message},
+		{"Request ID", i.RequestID},
+	} {
+		if v.value != "" {
+			lines = append(lines, fmt.Sprintf("%s: %s", v.label, v.value))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// AWSErrorDetail returns the structured details of any AWS API error in err's tree,
+// formatted for use as a diagnostic's detail, or an empty string.
+func AWSErrorDetail(err error) string {
+	if info, ok := AWSErrorInfoFrom(err); ok {
+		return info.String()
+	}
+
+	return ""
+}
+
+// ErrorDetail returns err's message followed by the structured details of any AWS API error in err's tree,
+// formatted for use as a diagnostic's detail.
+func ErrorDetail(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if detail := AWSErrorDetail(err); detail != "" {
+		return err.Error() + "\n\n" + detail
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAWSErrorInfoFrom(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &smithy.GenericAPIError{
+		Code:    "ResourceConflictException",
+		Message: "The function is currently in the following state: Pending",
+	}
+	operationErr := &smithy.OperationError{
+		ServiceID:     "Lambda",
+		OperationName: "CreateFunctionUrlConfig",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusConflict}},
+				Err:      apiErr,
+			},
+			RequestID: "0b4d3a2c-9f1e-4b6a-8c7d-5e2f1a0b9c8d",
+		},
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected errs.AWSErrorInfo
+		ok       bool
+	}{
+		"nil": {},
+		"not AWS": {
+			err: errors.New("test"),
+		},
+		"API error": {
+			err: apiErr,
+			expected: errs.AWSErrorInfo{
+				Code:    "ResourceConflictException",
+				Message: "The function is currently in the following state: Pending",
+			},
+			ok: true,
+		},
+		"operation error": {
+			err: fmt.Errorf("wrapped: %w", operationErr),
+			expected: errs.AWSErrorInfo{
+				Code:      "ResourceConflictException",
+				Message:   "The function is currently in the following state: Pending",
+				Operation: "CreateFunctionUrlConfig",
+				RequestID: "0b4d3a2c-9f1e-4b6a-8c7d-5e2f1a0b9c8d",
+				Service:   "Lambda",
+			},
+			ok: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.AWSErrorInfoFrom(testCase.err)
+
+			if ok != testCase.ok {
+				t.Errorf("got ok %t, expected %t", ok, testCase.ok)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAWSErrorInfoString(t *testing.T) {
+	t.Parallel()
+
+	info := errs.AWSErrorInfo{
+		Code:      "AccessDeniedException",
+		Operation: "GetFunction",
+		RequestID: "abc-123",
+		Service:   "Lambda",
+	}
+	expected := `Service: Lambda
+Operation: GetFunction
+Error Code: AccessDeniedException
+Request ID: abc-123`
+
+	if got := info.String(); got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -38,6 +39,12 @@ func DiagnosticString(d diag.Diagnostic) string {
 	return buf.String()
 }
 
+// NewErrorDiagnostic returns an error diagnostic whose detail is the error's message followed by,
+// for AWS API errors, the error code, message, request ID and operation name.
+func NewErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(summary, errs.ErrorDetail(err))
+}
+
 func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 	return diag.NewWarningDiagnostic(
 		"AWS resource not found during refresh",
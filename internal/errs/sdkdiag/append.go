@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,46 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with the formatted summary.
+// If any of the format arguments is an AWS API error, its code, message, request ID and operation name are
+// included in the diagnostic's detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAWSErrorDetail(diag.Errorf(format, a...), a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
+// AppendFromErr appends an error diagnostic with the error's message as summary.
+// If the error is an AWS API error, its code, message, request ID and operation name are included in the
+// diagnostic's detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withAWSErrorDetail(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+}
+
+// withAWSErrorDetail sets the detail of diagnostics without one from the first AWS API error in a.
+func withAWSErrorDetail(diags diag.Diagnostics, a ...any) diag.Diagnostics {
+	for _, v := range a {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+
+		detail := errs.AWSErrorDetail(err)
+		if detail == "" {
+			continue
+		}
+
+		for i := range diags {
+			if diags[i].Detail == "" {
+				diags[i].Detail = detail
+			}
+		}
+
+		break
+	}
+
+	return diags
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"testing"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName    string
+		err         error
+		wantSummary string
+		wantDetail  string
+	}{
+		{
+			testName:    "non-AWS error",
+			err:         errors.New("test"),
+			wantSummary: "creating Thing (id): test",
+		},
+		{
+			testName: "AWS API error",
+			err: &smithy.OperationError{
+				ServiceID:     "Thing",
+				OperationName: "CreateThing",
+				Err:           &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			},
+			wantSummary: "creating Thing (id): operation error Thing: CreateThing, api error ThrottlingException: Rate exceeded",
+			wantDetail:  "Service: Thing\nOperation: CreateThing\nError Code: ThrottlingException\nMessage: Rate exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := sdkdiag.AppendErrorf(nil, "creating Thing (%s): %s", "id", testCase.err)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("got %d diagnostics, want %d", got, want)
+			}
+			if got, want := diags[0].Summary, testCase.wantSummary; got != want {
+				t.Errorf("got summary %q, want %q", got, want)
+			}
+			if got, want := diags[0].Detail, testCase.wantDetail; got != want {
+				t.Errorf("got detail %q, want %q", got, want)
+			}
+		})
+	}
+}
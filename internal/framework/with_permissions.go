@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourcePermissions lists the IAM actions called by each of a resource's operations.
+type ResourcePermissions = types.ServicePackageResourcePermissions
+
+// ResourceWithPermissions is implemented by resources which declare the IAM actions their operations call.
+// The declared actions are used by the provider's pre-apply permission simulation.
+type ResourceWithPermissions interface {
+	Permissions(context.Context) ResourcePermissions
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -39,7 +40,8 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 
 // CreateTimeout returns any configured Create timeout value or the default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := w.defaultCreateTimeoutFromContext(ctx)
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]interface{}{
@@ -47,7 +49,7 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
@@ -71,7 +73,8 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 
 // UpdateTimeout returns any configured Update timeout value or the default value.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := w.defaultUpdateTimeoutFromContext(ctx)
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]interface{}{
@@ -79,7 +82,7 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
@@ -87,7 +90,8 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 
 // DeleteTimeout returns any configured Delete timeout value or the default value.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := w.defaultDeleteTimeoutFromContext(ctx)
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]interface{}{
@@ -95,8 +99,38 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
+
+// defaultCreateTimeoutFromContext returns any provider-configured override of the resource's default Create timeout value.
+// Only resources that set a default Create timeout value are overridden.
+func (w *WithTimeouts) defaultCreateTimeoutFromContext(ctx context.Context) time.Duration {
+	if v, ok := conns.DefaultTimeoutsFromContext(ctx); ok && v.Create > 0 && w.defaultCreateTimeout > 0 {
+		return v.Create
+	}
+
+	return w.defaultCreateTimeout
+}
+
+// defaultUpdateTimeoutFromContext returns any provider-configured override of the resource's default Update timeout value.
+// Only resources that set a default Update timeout value are overridden.
+func (w *WithTimeouts) defaultUpdateTimeoutFromContext(ctx context.Context) time.Duration {
+	if v, ok := conns.DefaultTimeoutsFromContext(ctx); ok && v.Update > 0 && w.defaultUpdateTimeout > 0 {
+		return v.Update
+	}
+
+	return w.defaultUpdateTimeout
+}
+
+// defaultDeleteTimeoutFromContext returns any provider-configured override of the resource's default Delete timeout value.
+// Only resources that set a default Delete timeout value are overridden.
+func (w *WithTimeouts) defaultDeleteTimeoutFromContext(ctx context.Context) time.Duration {
+	if v, ok := conns.DefaultTimeoutsFromContext(ctx); ok && v.Delete > 0 && w.defaultDeleteTimeout > 0 {
+		return v.Delete
+	}
+
+	return w.defaultDeleteTimeout
+}
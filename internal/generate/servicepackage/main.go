@@ -125,6 +125,17 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	Permissions             bool
+	PermissionsCreate       []string
+	PermissionsUpdate       []string
+	PermissionsDelete       []string
+	MovedFrom               []MovedFromDatum
+	OverrideProvider        bool
+}
+
+type MovedFromDatum struct {
+	TypeName   string
+	StateMover string // State mover function name. Empty if the state is moved unchanged.
 }
 
 type ServiceDatum struct {
@@ -228,6 +239,52 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Permissions" {
+			args := common.ParseArgs(m[3])
+
+			if d.Permissions {
+				v.errs = append(v.errs, fmt.Errorf("multiple Permissions annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.Permissions = true
+
+			// Actions are separated by semicolons, e.g. @Permissions(create="svc:CreateThing;svc:DescribeThing").
+			if attr, ok := args.Keyword["create"]; ok {
+				d.PermissionsCreate = strings.Split(attr, ";")
+			}
+
+			if attr, ok := args.Keyword["update"]; ok {
+				d.PermissionsUpdate = strings.Split(attr, ";")
+			}
+
+			if attr, ok := args.Keyword["delete"]; ok {
+				d.PermissionsDelete = strings.Split(attr, ";")
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "MovedFrom" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no MovedFrom type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			movedFrom := MovedFromDatum{
+				TypeName: args.Positional[0],
+			}
+
+			if attr, ok := args.Keyword["stateMover"]; ok {
+				movedFrom.StateMover = attr
+			}
+
+			d.MovedFrom = append(d.MovedFrom, movedFrom)
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "OverrideProvider" {
+			d.OverrideProvider = true
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -250,12 +307,23 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.ephemeralResources = append(v.ephemeralResources, d)
 				}
 			case "FrameworkDataSource":
+				if d.OverrideProvider {
+					v.errs = append(v.errs, fmt.Errorf("OverrideProvider annotation on Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
 			case "FrameworkResource":
+				// The override_provider block is added to Plugin SDK schemas only.
+				if d.OverrideProvider {
+					v.errs = append(v.errs, fmt.Errorf("OverrideProvider annotation on Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -285,9 +353,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				if _, ok := v.sdkResources[typeName]; ok {
 					v.errs = append(v.errs, fmt.Errorf("duplicate SDK Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
+					d := d
+					// A resource type's state cannot be moved to itself, e.g. when the function also implements the deprecated type.
+					d.MovedFrom = slices.DeleteFunc(slices.Clone(d.MovedFrom), func(v MovedFromDatum) bool { return v.TypeName == typeName })
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "MovedFrom", "OverrideProvider", "Permissions", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
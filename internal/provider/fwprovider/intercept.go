@@ -525,6 +525,13 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 	}
+
+	if !response.Diagnostics.HasError() {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		var metadata resource.MetadataResponse
+		w.inner.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+		response.Diagnostics.Append(simulatePermissions(ctx, w.meta, w.inner, metadata.TypeName, request, response)...)
+	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// simulatePermissions simulates the IAM permissions required by a resource's planned change
+// and returns a warning diagnostic if the principal is not allowed to call any of the actions.
+// If the resource has a planned change but doesn't declare the actions it calls, a warning is returned the first time only.
+func simulatePermissions(ctx context.Context, meta *conns.AWSClient, r resource.Resource, typeName string, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil {
+		return diags
+	}
+
+	if config := meta.PermissionSimulationConfig(ctx); config == nil || !config.Enabled {
+		return diags
+	}
+
+	v, ok := r.(framework.ResourceWithPermissions)
+	if !ok {
+		if !conns.HasPlannedChange(request.State.Raw, request.Plan.Raw) {
+			return diags
+		}
+
+		if summary, detail := meta.PermissionsNotDeclared(ctx, typeName); summary != "" {
+			diags.AddWarning(summary, detail)
+		}
+
+		return diags
+	}
+
+	actions := conns.PlannedActions(v.Permissions(ctx), request.State.Raw, request.Plan.Raw, len(response.RequiresReplace) > 0)
+
+	if summary, detail := meta.SimulatePermissions(ctx, typeName, actions); summary != "" {
+		diags.AddWarning(summary, detail)
+	}
+
+	return diags
+}
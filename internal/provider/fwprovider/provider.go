@@ -59,6 +59,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file to which a JSON-formatted entry is appended for every AWS API call. Each entry records the service, operation, request ID, latency and calling resource type.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -259,6 +263,12 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types to which no default tags are applied. " +
+								"A trailing `*` matches all resource types with that prefix, e.g. `aws_autoscaling_*`.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -266,6 +276,56 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"override_rules": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to override the default tags of resources, by resource type.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Default tag keys that are not applied to the matching resources.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource types whose default tags are overridden. " +
+											"A trailing `*` matches all resource types with that prefix, e.g. `aws_elastic_beanstalk_*`.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Additional default tags applied to the matching resources.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to override the default timeouts of resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for creating the matching resources, e.g. `90m`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for deleting the matching resources, e.g. `90m`.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Resource types whose default timeouts are overridden. " +
+								"A trailing `*` matches all resource types with that prefix, e.g. `aws_db_*`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for updating the matching resources, e.g. `90m`.",
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -291,6 +351,80 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"permission_simulation": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to simulate the IAM permissions required by planned resource changes.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to simulate the IAM permissions required by planned resource changes and report missing permissions as warnings.",
+						},
+						"principal_arn": schema.StringAttribute{
+							Optional: true,
+							Description: "ARN of the IAM user or role whose permissions are simulated. " +
+								"If not set, the principal of the provider's credentials is used.",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to override how API requests to specific services are retried.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request to the matching services is being executed.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries of API requests to the matching services are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Services whose retry settings are overridden, e.g. `lambda`. Use the same names as in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"roles_anywhere": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block for sourcing base credentials from IAM Roles Anywhere using an X.509 certificate.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_path": schema.StringAttribute{
+							Required:    true,
+							Description: "Path to the PEM-encoded X.509 end-entity certificate, optionally followed by its intermediate certificates.",
+						},
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"private_key_path": schema.StringAttribute{
+							Required:    true,
+							Description: "Path to the unencrypted PEM-encoded RSA or EC private key of the certificate.",
+						},
+						"profile_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+						},
+						"role_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Role to assume.",
+						},
+						"trust_anchor_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -336,7 +470,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -407,9 +541,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+					ctx = conns.NewDefaultTimeoutsContext(ctx, meta.DefaultTimeouts(ctx, typeName))
 					ctx = meta.RegisterLogger(ctx)
 					ctx = flex.RegisterLogger(ctx)
 				}
@@ -486,9 +621,13 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					continue
 				}
 
+				metadataResponse := ephemeral.MetadataResponse{}
+				inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+				typeName := metadataResponse.TypeName
+
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, typeName)
 					if meta != nil {
 						ctx = meta.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
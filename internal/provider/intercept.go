@@ -93,6 +93,14 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		if d != nil {
+			meta, diags = overrideProviderMeta(ctx, d, meta)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// moveStateProviderServer wraps the Plugin SDK provider server and moves the state of resource types,
+// typically deprecated ones, to the Plugin SDK resource types that declare they can be moved from them.
+// The Plugin SDK does not support moving resource state, so the move is done at the protocol level.
+type moveStateProviderServer struct {
+	tfprotov5.ProviderServer
+
+	movedFrom map[string]map[string]types.ServicePackageResourceMovedFrom // Keyed by target and then source resource type name.
+	provider  *schema.Provider
+}
+
+func newMoveStateProviderServer(ctx context.Context, provider *schema.Provider, server tfprotov5.ProviderServer) *moveStateProviderServer {
+	movedFrom := make(map[string]map[string]types.ServicePackageResourceMovedFrom)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			for _, from := range v.MovedFrom {
+				if _, ok := movedFrom[v.TypeName]; !ok {
+					movedFrom[v.TypeName] = make(map[string]types.ServicePackageResourceMovedFrom)
+				}
+				movedFrom[v.TypeName][from.TypeName] = from
+			}
+		}
+	}
+
+	return &moveStateProviderServer{
+		ProviderServer: server,
+		movedFrom:      movedFrom,
+		provider:       provider,
+	}
+}
+
+func (s *moveStateProviderServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	from, ok := s.movedFrom[request.TargetTypeName][request.SourceTypeName]
+
+	if !ok || !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+		return s.ProviderServer.MoveResourceState(ctx, request)
+	}
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+
+	r, ok := s.provider.ResourcesMap[request.TargetTypeName]
+	if !ok {
+		response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, fmt.Errorf("unknown resource type: %s", request.TargetTypeName)))
+
+		return response, nil
+	}
+
+	if request.SourceState == nil || request.SourceState.JSON == nil {
+		response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, fmt.Errorf("source state is not JSON encoded")))
+
+		return response, nil
+	}
+
+	tflog.Info(ctx, "moving resource state", map[string]any{
+		"source_resource_type": request.SourceTypeName,
+		"source_version":       request.SourceSchemaVersion,
+		"target_resource_type": request.TargetTypeName,
+	})
+
+	rawState, version, private := request.SourceState, request.SourceSchemaVersion, request.SourcePrivate
+
+	if from.StateMover == nil {
+		// The source and target resources share a schema, so any older state is upgraded as usual.
+		if version > int64(r.SchemaVersion) {
+			response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, fmt.Errorf("source schema version (%d) is newer than target schema version (%d)", version, r.SchemaVersion)))
+
+			return response, nil
+		}
+	} else {
+		var state map[string]interface{}
+
+		if err := json.Unmarshal(rawState.JSON, &state); err != nil {
+			response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, err))
+
+			return response, nil
+		}
+
+		state, err := from.StateMover(ctx, state, s.provider.Meta())
+
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, err))
+
+			return response, nil
+		}
+
+		v, err := json.Marshal(state)
+
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, moveStateErrorDiagnostic(request, err))
+
+			return response, nil
+		}
+
+		// The transformed state conforms to the target resource's current schema.
+		// Private state, e.g. timeouts, is specific to the source resource.
+		rawState, version, private = &tfprotov5.RawState{JSON: v}, int64(r.SchemaVersion), nil
+	}
+
+	// Upgrading the state also removes any attributes not in the target resource's schema and adds any missing ones.
+	upgraded, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		RawState: rawState,
+		TypeName: request.TargetTypeName,
+		Version:  version,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Diagnostics = append(response.Diagnostics, upgraded.Diagnostics...)
+	if hasErrorDiagnostic(response.Diagnostics) {
+		return response, nil
+	}
+
+	response.TargetPrivate = private
+	response.TargetState = upgraded.UpgradedState
+
+	return response, nil
+}
+
+func moveStateErrorDiagnostic(request *tfprotov5.MoveResourceStateRequest, err error) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("moving resource state from %s to %s", request.SourceTypeName, request.TargetTypeName),
+		Detail:   err.Error(),
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestMoveStateProviderServerMoveResourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resource := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		}
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test_new":  resource(),
+			"aws_test_old":  resource(),
+			"aws_test_used": resource(),
+		},
+	}
+	server := &moveStateProviderServer{
+		ProviderServer: provider.GRPCProvider(),
+		movedFrom: map[string]map[string]types.ServicePackageResourceMovedFrom{
+			"aws_test_new": {
+				"aws_test_old": {
+					TypeName: "aws_test_old",
+				},
+				"aws_test_used": {
+					TypeName: "aws_test_used",
+					StateMover: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+						rawState["name"] = rawState["old_name"]
+						delete(rawState, "old_name")
+						return rawState, nil
+					},
+				},
+			},
+		},
+		provider: provider,
+	}
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		sourceTypeName  string
+		sourceState     string
+		providerAddress string
+		wantName        string
+		wantError       bool
+	}{
+		"unchanged": {
+			sourceTypeName:  "aws_test_old",
+			sourceState:     `{"id":"test","name":"test-name"}`,
+			providerAddress: "registry.terraform.io/hashicorp/aws",
+			wantName:        "test-name",
+		},
+		"transformed": {
+			sourceTypeName:  "aws_test_used",
+			sourceState:     `{"id":"test","old_name":"test-name","extra":"removed"}`,
+			providerAddress: "registry.terraform.io/hashicorp/aws",
+			wantName:        "test-name",
+		},
+		"unknown source type": {
+			sourceTypeName:  "aws_test_unknown",
+			sourceState:     `{"id":"test","name":"test-name"}`,
+			providerAddress: "registry.terraform.io/hashicorp/aws",
+			wantError:       true,
+		},
+		"other provider": {
+			sourceTypeName:  "aws_test_old",
+			sourceState:     `{"id":"test","name":"test-name"}`,
+			providerAddress: "registry.terraform.io/example/aws-fork",
+			wantError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: testCase.providerAddress,
+				SourceState:           &tfprotov5.RawState{JSON: []byte(testCase.sourceState)},
+				SourceTypeName:        testCase.sourceTypeName,
+				TargetTypeName:        "aws_test_new",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := hasErrorDiagnostic(response.Diagnostics), testCase.wantError; got != want {
+				t.Fatalf("got error diagnostic %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.wantError {
+				return
+			}
+
+			state, err := response.TargetState.Unmarshal(stateType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attributes map[string]tftypes.Value
+			if err := state.As(&attributes); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got string
+			if err := attributes["name"].As(&got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.wantName; got != want {
+				t.Errorf("got name %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	overrideProviderAttributeName = "override_provider"
+)
+
+// overrideProviderAssumeRoleSchema returns the schema of the resource-level `override_provider.assume_role` block.
+func overrideProviderAssumeRoleSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 1224),
+						validation.StringMatch(regexache.MustCompile(`[\w+=,.@:\/\-]*`), ""),
+					),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume, instead of the provider's credentials, for the API calls of this resource.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					Description:  "An identifier for the assumed role session.",
+					ValidateFunc: validAssumeRoleSessionName,
+				},
+			},
+		},
+	}
+}
+
+// addOverrideProviderSchema adds the resource-level `override_provider` block to a resource's or data source's schema.
+// If the schema already has an `override_provider` block, the `assume_role` block is added to it.
+// Resources that can't be updated in-place must be replaced when the override changes.
+func addOverrideProviderSchema(s map[string]*schema.Schema, forceNew bool) {
+	if v, ok := s[overrideProviderAttributeName]; ok {
+		if v, ok := v.Elem.(*schema.Resource); ok {
+			if _, ok := v.Schema["assume_role"]; !ok {
+				v.Schema["assume_role"] = overrideProviderAssumeRoleSchema(forceNew)
+			}
+		}
+
+		return
+	}
+
+	s[overrideProviderAttributeName] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Description: "Configuration block with settings that override the provider configuration for this resource.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"assume_role": overrideProviderAssumeRoleSchema(forceNew),
+			},
+		},
+	}
+}
+
+// addOverrideProvider adds the resource-level `override_provider` block to the resource's or data source's schema.
+func addOverrideProvider(r *schema.Resource, forceNew bool) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			addOverrideProviderSchema(s, forceNew)
+			return s
+		}
+	} else if r.Schema != nil {
+		addOverrideProviderSchema(r.Schema, forceNew)
+	}
+}
+
+// overrideProviderMeta returns the provider Meta to use for a resource's or data source's API calls.
+// If the resource has an `override_provider.assume_role` block, API calls are made using credentials for that role.
+func overrideProviderMeta(ctx context.Context, d schemaResourceData, meta any) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return meta, diags
+	}
+
+	// Get returns nil if the schema has no `override_provider` block.
+	tfList, ok := d.Get(overrideProviderAttributeName).([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return meta, diags
+	}
+
+	tfList, ok = tfList[0].(map[string]interface{})["assume_role"].([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return meta, diags
+	}
+
+	override := expandAssumeRoleOverride(tfList[0].(map[string]interface{}))
+
+	meta, err := c.WithAssumeRole(ctx, override)
+
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "configuring %s.assume_role (%s): %s", overrideProviderAttributeName, override.RoleARN, err)
+	}
+
+	return meta, diags
+}
+
+func expandAssumeRoleOverride(tfMap map[string]interface{}) conns.AssumeRoleOverride {
+	apiObject := conns.AssumeRoleOverride{}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		apiObject.Duration = duration
+	}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		apiObject.ExternalID = v
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		apiObject.SessionName = v
+	}
+
+	return apiObject
+}
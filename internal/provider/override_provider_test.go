@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAddOverrideProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   map[string]*schema.Schema
+		forceNew bool
+	}{
+		"no override_provider": {
+			schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"no override_provider ForceNew": {
+			schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+			forceNew: true,
+		},
+		"existing override_provider": {
+			schema: map[string]*schema.Schema{
+				overrideProviderAttributeName: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"default_tags": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"tags": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			addOverrideProviderSchema(testCase.schema, testCase.forceNew)
+
+			v, ok := testCase.schema[overrideProviderAttributeName]
+			if !ok {
+				t.Fatalf("no %s attribute", overrideProviderAttributeName)
+			}
+
+			elem, ok := v.Elem.(*schema.Resource)
+			if !ok {
+				t.Fatalf("unexpected %s Elem: %T", overrideProviderAttributeName, v.Elem)
+			}
+
+			assumeRole, ok := elem.Schema["assume_role"]
+			if !ok {
+				t.Fatalf("no %s.assume_role attribute", overrideProviderAttributeName)
+			}
+
+			if got, want := assumeRole.ForceNew, testCase.forceNew; got != want {
+				t.Errorf("assume_role ForceNew = %t, want %t", got, want)
+			}
+
+			if err := schema.InternalMap(testCase.schema).InternalValidate(nil); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestOverrideProviderOptIn(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		resources map[string]*schema.Resource
+		typeName  string
+		expected  bool
+		forceNew  bool
+	}{
+		"opted in resource": {
+			resources: p.ResourcesMap,
+			typeName:  "aws_iam_role",
+			expected:  true,
+		},
+		"opted in resource without Update": {
+			resources: p.ResourcesMap,
+			typeName:  "aws_iam_role_policy_attachment",
+			expected:  true,
+			forceNew:  true,
+		},
+		"opted in data source": {
+			resources: p.DataSourcesMap,
+			typeName:  "aws_iam_role",
+			expected:  true,
+		},
+		"not opted in resource": {
+			resources: p.ResourcesMap,
+			typeName:  "aws_iam_user",
+		},
+		"not opted in data source": {
+			resources: p.DataSourcesMap,
+			typeName:  "aws_iam_user",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, ok := testCase.resources[testCase.typeName]
+			if !ok {
+				t.Fatalf("no %s", testCase.typeName)
+			}
+
+			v, ok := r.SchemaMap()[overrideProviderAttributeName]
+			if got, want := ok, testCase.expected; got != want {
+				t.Fatalf("%s attribute = %t, want %t", overrideProviderAttributeName, got, want)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got, want := v.ForceNew, testCase.forceNew; got != want {
+				t.Errorf("%s ForceNew = %t, want %t", overrideProviderAttributeName, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// permissionSimulationProviderServer wraps the Plugin SDK provider server and simulates the IAM permissions
+// required by planned changes to Plugin SDK resources that declare the IAM actions they call.
+// Planned changes to resources that don't declare their actions are reported, once per provider instance, as not simulated.
+// Plugin SDK CustomizeDiff functions cannot return warnings, so the simulation is done at the protocol level.
+type permissionSimulationProviderServer struct {
+	tfprotov5.ProviderServer
+
+	permissions map[string]*types.ServicePackageResourcePermissions // Keyed by resource type name.
+	provider    *schema.Provider
+
+	resourceTypes     map[string]tftypes.Type
+	resourceTypesErr  error
+	resourceTypesOnce sync.Once
+}
+
+func newPermissionSimulationProviderServer(ctx context.Context, provider *schema.Provider) *permissionSimulationProviderServer {
+	permissions := make(map[string]*types.ServicePackageResourcePermissions)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Permissions != nil {
+				permissions[v.TypeName] = v.Permissions
+			}
+		}
+	}
+
+	return &permissionSimulationProviderServer{
+		ProviderServer: provider.GRPCProvider(),
+		permissions:    permissions,
+		provider:       provider,
+	}
+}
+
+func (s *permissionSimulationProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) {
+		return response, err
+	}
+
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || meta == nil {
+		return response, nil
+	}
+
+	if config := meta.PermissionSimulationConfig(ctx); config == nil || !config.Enabled {
+		return response, nil
+	}
+
+	prior, planned, err := s.resourceStates(ctx, request.TypeName, request.PriorState, response.PlannedState)
+
+	if err != nil {
+		tflog.Warn(ctx, "decoding resource states for IAM permission simulation", map[string]any{
+			"resource_type": request.TypeName,
+			"error":         err.Error(),
+		})
+
+		return response, nil
+	}
+
+	permissions, ok := s.permissions[request.TypeName]
+	if !ok {
+		if !conns.HasPlannedChange(prior, planned) {
+			return response, nil
+		}
+
+		if summary, detail := meta.PermissionsNotDeclared(ctx, request.TypeName); summary != "" {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  summary,
+				Detail:   detail,
+			})
+		}
+
+		return response, nil
+	}
+
+	actions := conns.PlannedActions(*permissions, prior, planned, len(response.RequiresReplace) > 0)
+
+	if summary, detail := meta.SimulatePermissions(ctx, request.TypeName, actions); summary != "" {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  summary,
+			Detail:   detail,
+		})
+	}
+
+	return response, nil
+}
+
+// resourceStates decodes a resource's prior and planned states.
+func (s *permissionSimulationProviderServer) resourceStates(ctx context.Context, typeName string, prior, planned *tfprotov5.DynamicValue) (tftypes.Value, tftypes.Value, error) {
+	s.resourceTypesOnce.Do(func() {
+		var response *tfprotov5.GetProviderSchemaResponse
+		response, s.resourceTypesErr = s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if s.resourceTypesErr != nil {
+			return
+		}
+
+		s.resourceTypes = make(map[string]tftypes.Type)
+		for k, v := range response.ResourceSchemas {
+			s.resourceTypes[k] = v.ValueType()
+		}
+	})
+
+	if s.resourceTypesErr != nil {
+		return tftypes.Value{}, tftypes.Value{}, s.resourceTypesErr
+	}
+
+	typ, ok := s.resourceTypes[typeName]
+	if !ok {
+		return tftypes.Value{}, tftypes.Value{}, fmt.Errorf("no schema for resource type %s", typeName)
+	}
+
+	decode := func(v *tfprotov5.DynamicValue) (tftypes.Value, error) {
+		if v == nil {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		return v.Unmarshal(typ)
+	}
+
+	priorValue, err := decode(prior)
+
+	if err != nil {
+		return tftypes.Value{}, tftypes.Value{}, err
+	}
+
+	plannedValue, err := decode(planned)
+
+	if err != nil {
+		return tftypes.Value{}, tftypes.Value{}, err
+	}
+
+	return priorValue, plannedValue, nil
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, v := range diags {
+		if v != nil && v.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -54,6 +55,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a file to which a JSON-formatted entry is appended for every AWS API call. " +
+					"Each entry records the service, operation, request ID, latency and calling resource type.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -68,6 +75,41 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which no default tags are applied. " +
+								"A trailing `*` matches all resource types with that prefix, e.g. `aws_autoscaling_*`.",
+						},
+						"override_rules": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with settings to override the default tags of resources, by resource type.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys that are not applied to the matching resources.",
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types whose default tags are overridden. " +
+											"A trailing `*` matches all resource types with that prefix, e.g. `aws_elastic_beanstalk_*`.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Additional default tags applied to the matching resources.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -78,6 +120,40 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with settings to override the default timeouts of resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for creating the matching resources, e.g. `90m`.",
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for deleting the matching resources, e.g. `90m`.",
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types whose default timeouts are overridden. " +
+								"A trailing `*` matches all resource types with that prefix, e.g. `aws_db_*`.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for updating the matching resources, e.g. `90m`.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +228,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"permission_simulation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to simulate the IAM permissions required by planned resource changes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to simulate the IAM permissions required by planned resource changes and report missing permissions as warnings.",
+						},
+						"principal_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "ARN of the IAM user or role whose permissions are simulated. " +
+								"If not set, the principal of the provider's credentials is used.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -164,12 +261,84 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with settings to override how API requests to specific services are retried.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of times an AWS API request to the matching services is being executed.",
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
+							Description:  "Specifies how retries of API requests to the matching services are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"services": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Services whose retry settings are overridden, e.g. `lambda`. Use the same names as in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"roles_anywhere": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"access_key", "secret_key", "token"},
+				Description:   "Configuration block for sourcing base credentials from IAM Roles Anywhere using an X.509 certificate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to the PEM-encoded X.509 end-entity certificate, optionally followed by its intermediate certificates.",
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The duration, between 15 minutes and 12 hours, of the session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: validAssumeRoleDuration,
+						},
+						"private_key_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to the unencrypted PEM-encoded RSA or EC private key of the certificate.",
+						},
+						"profile_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+							ValidateFunc: verify.ValidARN,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Amazon Resource Name (ARN) of the IAM Role to assume.",
+							ValidateFunc: verify.ValidARN,
+						},
+						"trust_anchor_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -291,9 +460,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// The data source has opted in to per-resource provider overrides.
+			if v.OverrideProvider {
+				addOverrideProvider(r, false)
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -367,9 +541,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// The resource has opted in to per-resource provider overrides.
+			// Resources without an Update handler must be replaced when the override changes.
+			if v.OverrideProvider {
+				addOverrideProvider(r, r.UpdateWithoutTimeout == nil)
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -481,6 +661,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -560,7 +741,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			config.DefaultTagsExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["override_rules"].([]interface{}); ok && len(v) > 0 {
+			config.DefaultTagsOverrideRules = expandDefaultTagsOverrideRules(ctx, v)
+		}
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
@@ -592,6 +782,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("permission_simulation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.PermissionSimulationConfig = expandPermissionSimulation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTimeoutsConfig = expandDefaultTimeouts(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	} else {
@@ -602,6 +800,19 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 {
+		retries, dx := expandServiceRetries(v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetryConfigs = retries
+	}
+
+	if v, ok := d.GetOk("roles_anywhere"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.RolesAnywhere = expandRolesAnywhere(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -894,6 +1105,162 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+func expandDefaultTagsOverrideRules(ctx context.Context, tfList []interface{}) []conns.DefaultTagsOverrideRule {
+	var apiObjects []conns.DefaultTagsOverrideRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var apiObject conns.DefaultTagsOverrideRule
+
+		if v, ok := tfMap["exclude_keys"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ExcludeKeys = tftags.New(ctx, v.List())
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Tags = tftags.New(ctx, v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDefaultTimeouts(tfList []interface{}) []conns.DefaultTimeoutsConfig {
+	var apiObjects []conns.DefaultTimeoutsConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var apiObject conns.DefaultTimeoutsConfig
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		// Durations have already been validated.
+		if v, ok := tfMap["create"].(string); ok && v != "" {
+			apiObject.Create, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["update"].(string); ok && v != "" {
+			apiObject.Update, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["delete"].(string); ok && v != "" {
+			apiObject.Delete, _ = time.ParseDuration(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceRetries(tfList []interface{}) ([]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []conns.ServiceRetryConfig
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var apiObject conns.ServiceRetryConfig
+
+		if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+			for _, alias := range flex.ExpandStringValueSet(v) {
+				pkg, err := names.ProviderPackageForAlias(alias)
+
+				if err != nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(cty.GetAttrPath("retry").IndexInt(i).GetAttr("services"), "Invalid Attribute Value", err.Error()))
+					continue
+				}
+
+				apiObject.Services = append(apiObject.Services, pkg)
+			}
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok && v > 0 {
+			apiObject.MaxRetries = v
+		}
+
+		// Retry mode has already been validated.
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			apiObject.RetryMode, _ = aws.ParseRetryMode(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func expandPermissionSimulation(tfMap map[string]interface{}) *conns.PermissionSimulationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &conns.PermissionSimulationConfig{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["principal_arn"].(string); ok && v != "" {
+		apiObject.PrincipalARN = v
+	}
+
+	return apiObject
+}
+
+func expandRolesAnywhere(tfMap map[string]interface{}) *conns.RolesAnywhereConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &conns.RolesAnywhereConfig{}
+
+	if v, ok := tfMap["certificate_path"].(string); ok && v != "" {
+		apiObject.CertificatePath = v
+	}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		apiObject.Duration = duration
+	}
+
+	if v, ok := tfMap["private_key_path"].(string); ok && v != "" {
+		apiObject.PrivateKeyPath = v
+	}
+
+	if v, ok := tfMap["profile_arn"].(string); ok && v != "" {
+		apiObject.ProfileARN = v
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleARN = v
+	}
+
+	if v, ok := tfMap["trust_anchor_arn"].(string); ok && v != "" {
+		apiObject.TrustAnchorARN = v
+	}
+
+	return apiObject
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
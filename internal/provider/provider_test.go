@@ -8,11 +8,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -436,3 +439,124 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList   []interface{}
+		expected []conns.DefaultTimeoutsConfig
+	}{
+		"nil": {
+			tfList:   nil,
+			expected: nil,
+		},
+		"config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_db_*"}),
+					"create":         "90m",
+					"update":         "",
+					"delete":         "1h",
+				},
+				map[string]interface{}{
+					"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_instance"}),
+					"update":         "45m",
+				},
+			},
+			expected: []conns.DefaultTimeoutsConfig{
+				{
+					ResourceTypes: []string{"aws_db_*"},
+					Create:        90 * time.Minute,
+					Delete:        60 * time.Minute,
+				},
+				{
+					ResourceTypes: []string{"aws_instance"},
+					Update:        45 * time.Minute,
+				},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandDefaultTimeouts(testcase.tfList)
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandServiceRetries(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList        []interface{}
+		expected      []conns.ServiceRetryConfig
+		expectedDiags int
+	}{
+		"nil": {
+			tfList:   nil,
+			expected: nil,
+		},
+		"config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"services":    schema.NewSet(schema.HashString, []interface{}{"lambda"}),
+					"max_retries": 10,
+					"retry_mode":  "adaptive",
+				},
+				map[string]interface{}{
+					"services":    schema.NewSet(schema.HashString, []interface{}{"s3"}),
+					"max_retries": 5,
+					"retry_mode":  "",
+				},
+			},
+			expected: []conns.ServiceRetryConfig{
+				{
+					Services:   []string{names.Lambda},
+					MaxRetries: 10,
+					RetryMode:  aws.RetryModeAdaptive,
+				},
+				{
+					Services:   []string{names.S3},
+					MaxRetries: 5,
+				},
+			},
+		},
+		"unknown service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"services":   schema.NewSet(schema.HashString, []interface{}{"notaservice"}),
+					"retry_mode": "standard",
+				},
+			},
+			expected: []conns.ServiceRetryConfig{
+				{
+					RetryMode: aws.RetryModeStandard,
+				},
+			},
+			expectedDiags: 1,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceRetries(testcase.tfList)
+
+			if got, want := len(diags), testcase.expectedDiags; got != want {
+				t.Errorf("got %d diagnostics, want %d", got, want)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// defaultTimeoutsProviderServer wraps the Plugin SDK provider server and applies the provider's `default_timeouts`
+// configuration to Plugin SDK resources.
+// The Plugin SDK records a resource's timeouts in the planned private state when planning and ResourceData.Timeout
+// reads them from there when applying, so the overrides are applied to the planned private state at the protocol level
+// rather than by modifying the resource definitions.
+type defaultTimeoutsProviderServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+
+	resourceTypes     map[string]tftypes.Type
+	resourceTypesErr  error
+	resourceTypesOnce sync.Once
+}
+
+func newDefaultTimeoutsProviderServer(_ context.Context, provider *schema.Provider, server tfprotov5.ProviderServer) *defaultTimeoutsProviderServer {
+	return &defaultTimeoutsProviderServer{
+		ProviderServer: server,
+		provider:       provider,
+	}
+}
+
+func (s *defaultTimeoutsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) || len(response.PlannedPrivate) == 0 {
+		return response, err
+	}
+
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || meta == nil {
+		return response, nil
+	}
+
+	overrides := meta.DefaultTimeouts(ctx, request.TypeName)
+	if overrides == (conns.ResourceTimeouts{}) {
+		return response, nil
+	}
+
+	configured, err := s.configuredTimeouts(ctx, request.TypeName, request.Config)
+
+	if err != nil {
+		tflog.Warn(ctx, "decoding resource configuration for default timeouts", map[string]any{
+			"resource_type": request.TypeName,
+			"error":         err.Error(),
+		})
+
+		return response, nil
+	}
+
+	// The configuration is null when the resource is destroyed.
+	// The delete timeout recorded in the prior private state, which already includes any override, is used.
+	if configured == nil {
+		return response, nil
+	}
+
+	private, err := overrideTimeoutsPrivateState(response.PlannedPrivate, overrides, configured)
+
+	if err != nil {
+		tflog.Warn(ctx, "applying default timeouts", map[string]any{
+			"resource_type": request.TypeName,
+			"error":         err.Error(),
+		})
+
+		return response, nil
+	}
+
+	response.PlannedPrivate = private
+
+	return response, nil
+}
+
+// configuredTimeouts returns the operations whose timeouts are set in a resource's `timeouts` configuration block.
+// nil is returned if the configuration is null.
+func (s *defaultTimeoutsProviderServer) configuredTimeouts(ctx context.Context, typeName string, config *tfprotov5.DynamicValue) (map[string]bool, error) {
+	s.resourceTypesOnce.Do(func() {
+		var response *tfprotov5.GetProviderSchemaResponse
+		response, s.resourceTypesErr = s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if s.resourceTypesErr != nil {
+			return
+		}
+
+		s.resourceTypes = make(map[string]tftypes.Type)
+		for k, v := range response.ResourceSchemas {
+			s.resourceTypes[k] = v.ValueType()
+		}
+	})
+
+	if s.resourceTypesErr != nil {
+		return nil, s.resourceTypesErr
+	}
+
+	typ, ok := s.resourceTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no schema for resource type %s", typeName)
+	}
+
+	if config == nil {
+		return nil, nil
+	}
+
+	value, err := config.Unmarshal(typ)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	configured := make(map[string]bool)
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	timeouts, ok := attributes[schema.TimeoutsConfigKey]
+	if !ok || timeouts.IsNull() || !timeouts.IsKnown() {
+		return configured, nil
+	}
+
+	var operations map[string]tftypes.Value
+	if err := timeouts.As(&operations); err != nil {
+		return nil, err
+	}
+
+	for k, v := range operations {
+		configured[k] = !v.IsNull()
+	}
+
+	return configured, nil
+}
+
+// overrideTimeoutsPrivateState overrides the timeouts recorded in a Plugin SDK resource's private state.
+// Only operations for which the resource declares a timeout, and for which no timeout is configured, are overridden.
+func overrideTimeoutsPrivateState(private []byte, overrides conns.ResourceTimeouts, configured map[string]bool) ([]byte, error) {
+	var tfMap map[string]any
+	if err := json.Unmarshal(private, &tfMap); err != nil {
+		return nil, err
+	}
+
+	timeouts, ok := tfMap[schema.TimeoutKey].(map[string]any)
+	if !ok {
+		return private, nil
+	}
+
+	for k, v := range map[string]time.Duration{
+		schema.TimeoutCreate: overrides.Create,
+		schema.TimeoutUpdate: overrides.Update,
+		schema.TimeoutDelete: overrides.Delete,
+	} {
+		if _, ok := timeouts[k]; ok && v > 0 && !configured[k] && !configured[schema.TimeoutDefault] {
+			timeouts[k] = v.Nanoseconds()
+		}
+	}
+
+	return json.Marshal(tfMap)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestOverrideTimeoutsPrivateState(t *testing.T) {
+	t.Parallel()
+
+	private := func(timeouts map[string]any) []byte {
+		b, err := json.Marshal(map[string]any{
+			"schema_version":  "1",
+			schema.TimeoutKey: timeouts,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return b
+	}
+
+	testCases := map[string]struct {
+		private    []byte
+		overrides  conns.ResourceTimeouts
+		configured map[string]bool
+		expected   []byte
+	}{
+		"no timeouts": {
+			private:   []byte(`{"schema_version":"1"}`),
+			overrides: conns.ResourceTimeouts{Create: 90 * time.Minute},
+			expected:  []byte(`{"schema_version":"1"}`),
+		},
+		"declared operations overridden": {
+			private: private(map[string]any{
+				schema.TimeoutCreate: (10 * time.Minute).Nanoseconds(),
+				schema.TimeoutDelete: (10 * time.Minute).Nanoseconds(),
+			}),
+			overrides: conns.ResourceTimeouts{Create: 90 * time.Minute, Update: 90 * time.Minute},
+			expected: private(map[string]any{
+				schema.TimeoutCreate: (90 * time.Minute).Nanoseconds(),
+				schema.TimeoutDelete: (10 * time.Minute).Nanoseconds(),
+			}),
+		},
+		"configured operation not overridden": {
+			private: private(map[string]any{
+				schema.TimeoutCreate: (20 * time.Minute).Nanoseconds(),
+				schema.TimeoutDelete: (10 * time.Minute).Nanoseconds(),
+			}),
+			overrides:  conns.ResourceTimeouts{Create: 90 * time.Minute, Delete: 90 * time.Minute},
+			configured: map[string]bool{schema.TimeoutCreate: true},
+			expected: private(map[string]any{
+				schema.TimeoutCreate: (20 * time.Minute).Nanoseconds(),
+				schema.TimeoutDelete: (90 * time.Minute).Nanoseconds(),
+			}),
+		},
+		"configured default": {
+			private: private(map[string]any{
+				schema.TimeoutCreate: (20 * time.Minute).Nanoseconds(),
+			}),
+			overrides:  conns.ResourceTimeouts{Create: 90 * time.Minute},
+			configured: map[string]bool{schema.TimeoutDefault: true},
+			expected: private(map[string]any{
+				schema.TimeoutCreate: (20 * time.Minute).Nanoseconds(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := overrideTimeoutsPrivateState(testCase.private, testCase.overrides, testCase.configured)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), string(testCase.expected)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
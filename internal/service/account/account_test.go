@@ -20,6 +20,7 @@ func TestAccAccount_serial(t *testing.T) {
 		},
 		"PrimaryContact": {
 			acctest.CtBasic: testAccPrimaryContact_basic,
+			"AccountID":     testAccPrimaryContact_accountID,
 		},
 		"Region": {
 			acctest.CtBasic: testAccRegion_basic,
@@ -23,6 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	primaryContactDefaultID = "default"
+)
+
 // @SDKResource("aws_account_primary_contact")
 func resourcePrimaryContact() *schema.Resource {
 	return &schema.Resource{
@@ -101,7 +105,7 @@ func resourcePrimaryContactPut(ctx context.Context, d *schema.ResourceData, meta
 
 	conn := meta.(*conns.AWSClient).AccountClient(ctx)
 
-	id := "default"
+	id := primaryContactDefaultID
 	input := &account.PutContactInformationInput{
 		ContactInformation: &types.ContactInformation{
 			AddressLine1: aws.String(d.Get("address_line_1").(string)),
@@ -160,7 +164,13 @@ func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, met
 
 	conn := meta.(*conns.AWSClient).AccountClient(ctx)
 
-	contactInformation, err := findContactInformation(ctx, conn, d.Get(names.AttrAccountID).(string))
+	// The resource ID is the target account ID when managing a member account.
+	var accountID string
+	if id := d.Id(); id != primaryContactDefaultID {
+		accountID = id
+	}
+
+	contactInformation, err := findContactInformation(ctx, conn, accountID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Account Primary Contact (%s) not found, removing from state", d.Id())
@@ -172,7 +182,7 @@ func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading Account Primary Contact (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrAccountID, d.Get(names.AttrAccountID))
+	d.Set(names.AttrAccountID, accountID)
 	d.Set("address_line_1", contactInformation.AddressLine1)
 	d.Set("address_line_2", contactInformation.AddressLine2)
 	d.Set("address_line_3", contactInformation.AddressLine3)
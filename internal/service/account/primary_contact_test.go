@@ -72,6 +72,49 @@ func testAccPrimaryContact_basic(t *testing.T) {
 	})
 }
 
+func testAccPrimaryContact_accountID(t *testing.T) { // nosemgrep:ci.account-in-func-name
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_primary_contact.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryConfig_organization(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, "data.aws_caller_identity.test", names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "data.aws_caller_identity.test", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrimaryConfig_organization(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, "data.aws_caller_identity.test", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPrimaryContactExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -107,3 +150,25 @@ resource "aws_account_primary_contact" "test" {
 }
 `, name)
 }
+
+func testAccPrimaryConfig_organization(name string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_account_primary_contact" "test" {
+  account_id         = data.aws_caller_identity.test.account_id
+  address_line_1     = "123 Any Street"
+  city               = "Seattle"
+  company_name       = "Example Corp, Inc."
+  country_code       = "US"
+  district_or_county = "King"
+  full_name          = %[1]q
+  phone_number       = "+64211111111"
+  postal_code        = "98101"
+  state_or_region    = "WA"
+  website_url        = "https://www.examplecorp.com"
+}
+`, name))
+}
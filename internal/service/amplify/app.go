@@ -186,6 +186,11 @@ func resourceApp() *schema.Resource {
 					},
 				},
 			},
+			"compute_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"custom_headers": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -347,6 +352,10 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.CacheConfig = expandCacheConfig(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("compute_role_arn"); ok {
+		input.ComputeRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("custom_headers"); ok {
 		input.CustomHeaders = aws.String(v.(string))
 	}
@@ -438,6 +447,7 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface
 			return sdkdiag.AppendErrorf(diags, "setting cache_config: %s", err)
 		}
 	}
+	d.Set("compute_role_arn", app.ComputeRoleArn)
 	d.Set("custom_headers", app.CustomHeaders)
 	if err := d.Set("custom_rule", flattenCustomRules(app.CustomRules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting custom_rule: %s", err)
@@ -509,6 +519,10 @@ func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			}
 		}
 
+		if d.HasChange("compute_role_arn") {
+			input.ComputeRoleArn = aws.String(d.Get("compute_role_arn").(string))
+		}
+
 		if d.HasChange("custom_headers") {
 			input.CustomHeaders = aws.String(d.Get("custom_headers").(string))
 		}
@@ -324,6 +324,52 @@ func testAccApp_CacheConfig(t *testing.T) {
 	})
 }
 
+func testAccApp_ComputeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app1, app2, app3 types.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_app.test"
+	iamRole1ResourceName := "aws_iam_role.test1"
+	iamRole2ResourceName := "aws_iam_role.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_computeRoleARN(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app1),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", iamRole1ResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_computeRoleARN(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app2),
+					testAccCheckAppNotRecreated(&app1, &app2),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", iamRole2ResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccAppConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app3),
+					testAccCheckAppNotRecreated(&app2, &app3),
+					resource.TestCheckResourceAttr(resourceName, "compute_role_arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccApp_CustomRules(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app types.App
@@ -907,6 +953,17 @@ resource "aws_amplify_app" "test" {
 `, rName))
 }
 
+func testAccAppConfig_computeRoleARN(rName, roleResourceName string) string {
+	return acctest.ConfigCompose(testAccAppIAMServiceRoleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+
+  compute_role_arn = aws_iam_role.%[2]s.arn
+}
+`, rName, roleResourceName))
+}
+
 func testAccAppConfig_repository(rName, repository, accessToken string) string {
 	return fmt.Sprintf(`
 resource "aws_amplify_app" "test" {
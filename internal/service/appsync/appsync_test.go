@@ -115,6 +115,9 @@ func TestAccAppSync_serial(t *testing.T) {
 			acctest.CtDisappears: testAccAppSyncSourceAPIAssociation_disappears,
 			"update":             testAccAppSyncSourceAPIAssociation_update,
 		},
+		"SourceApiAssociationMerge": {
+			acctest.CtBasic: testAccAppSyncSourceAPIAssociationMerge_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -1282,6 +1282,13 @@ func testAccGraphQLAPI_introspectionConfig(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccGraphQLAPIConfig_introspectionConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "introspection_config", "ENABLED"),
+				),
+			},
 		},
 	})
 }
@@ -1310,6 +1317,20 @@ func testAccGraphQLAPI_queryDepthLimit(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccGraphQLAPIConfig_queryDepthLimit(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "query_depth_limit", "10"),
+				),
+			},
+			{
+				Config: testAccGraphQLAPIConfig_queryDepthLimit(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "query_depth_limit", "0"),
+				),
+			},
 		},
 	})
 }
@@ -1338,6 +1359,20 @@ func testAccGraphQLAPI_resolverCountLimit(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccGraphQLAPIConfig_resolverCountLimit(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "resolver_count_limit", "100"),
+				),
+			},
+			{
+				Config: testAccGraphQLAPIConfig_resolverCountLimit(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(ctx, resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "resolver_count_limit", "0"),
+				),
+			},
 		},
 	})
 }
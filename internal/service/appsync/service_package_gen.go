@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newSourceAPIAssociationMergeResource,
+			Name:    "Source API Association Merge",
+		},
 		{
 			Factory: newSourceAPIAssociationResource,
 			Name:    "Source API Association",
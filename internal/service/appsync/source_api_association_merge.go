@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_appsync_source_api_association_merge", name="Source API Association Merge")
+func newSourceAPIAssociationMergeResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sourceAPIAssociationMergeResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	resNameSourceAPIAssociationMerge = "Source API Association Merge"
+)
+
+type sourceAPIAssociationMergeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[sourceAPIAssociationMergeResourceModel]
+	framework.WithTimeouts
+}
+
+func (*sourceAPIAssociationMergeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appsync_source_api_association_merge"
+}
+
+func (*sourceAPIAssociationMergeResource) Permissions(context.Context) framework.ResourcePermissions {
+	return framework.ResourcePermissions{
+		Create: []string{"appsync:GetSourceApiAssociation", "appsync:StartSchemaMerge"},
+	}
+}
+
+func (r *sourceAPIAssociationMergeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAssociationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"merged_api_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_api_association_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SourceApiAssociationStatus](),
+				Computed:   true,
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *sourceAPIAssociationMergeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().AppSyncClient(ctx)
+
+	var plan sourceAPIAssociationMergeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	associationID, mergedAPIID := plan.AssociationID.ValueString(), plan.MergedAPIID.ValueString()
+	in := &appsync.StartSchemaMergeInput{
+		AssociationId:       flex.StringFromFramework(ctx, plan.AssociationID),
+		MergedApiIdentifier: flex.StringFromFramework(ctx, plan.MergedAPIID),
+	}
+
+	_, err := conn.StartSchemaMerge(ctx, in)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AppSync, create.ErrActionCreating, resNameSourceAPIAssociationMerge, associationID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	out, err := waitSourceAPIAssociationUpdated(ctx, conn, associationID, mergedAPIID, createTimeout)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AppSync, create.ErrActionWaitingForCreation, resNameSourceAPIAssociationMerge, associationID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.ID = types.StringValue(associationID)
+	plan.SourceAPIAssociationStatus = fwtypes.StringEnumValue(out.SourceApiAssociationStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *sourceAPIAssociationMergeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().AppSyncClient(ctx)
+
+	var state sourceAPIAssociationMergeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSourceAPIAssociationByTwoPartKey(ctx, conn, state.AssociationID.ValueString(), state.MergedAPIID.ValueString())
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AppSync, create.ErrActionSetting, resNameSourceAPIAssociationMerge, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.SourceAPIAssociationStatus = fwtypes.StringEnumValue(out.SourceApiAssociationStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *sourceAPIAssociationMergeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// A schema merge can't be undone. Removing the resource only removes it from state.
+}
+
+type sourceAPIAssociationMergeResourceModel struct {
+	AssociationID              types.String                                            `tfsdk:"association_id"`
+	ID                         types.String                                            `tfsdk:"id"`
+	MergedAPIID                types.String                                            `tfsdk:"merged_api_id"`
+	SourceAPIAssociationStatus fwtypes.StringEnum[awstypes.SourceApiAssociationStatus] `tfsdk:"source_api_association_status"`
+	Timeouts                   timeouts.Value                                          `tfsdk:"timeouts"`
+	Triggers                   fwtypes.MapOfString                                     `tfsdk:"triggers"`
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAppSyncSourceAPIAssociationMerge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var sourceapiassociation types.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association_merge.test"
+	associationResourceName := "aws_appsync_source_api_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationMergeConfig_basic(rName, "Int"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, associationResourceName, &sourceapiassociation),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAssociationID, associationResourceName, names.AttrAssociationID),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_id", associationResourceName, "merged_api_id"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_status", string(types.SourceApiAssociationStatusMergeSuccess)),
+				),
+			},
+			{
+				Config: testAccSourceAPIAssociationMergeConfig_basic(rName, "String"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_status", string(types.SourceApiAssociationStatusMergeSuccess)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociationMergeConfig_basic(rName, fieldType string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  assume_role_policy = data.aws_iam_policy_document.test.json
+  name_prefix        = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      identifiers = ["appsync.amazonaws.com"]
+      type        = "Service"
+    }
+    condition {
+      test     = "StringEquals"
+      values   = [data.aws_caller_identity.current.account_id]
+      variable = "aws:SourceAccount"
+    }
+
+    condition {
+      test     = "ArnLike"
+      values   = ["arn:${data.aws_partition.current.partition}:appsync:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}::apis/*"]
+      variable = "aws:SourceArn"
+    }
+  }
+}
+
+resource "aws_appsync_graphql_api" "merged" {
+  authentication_type           = "API_KEY"
+  name                          = %[1]q
+  api_type                      = "MERGED"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+  schema              = <<EOF
+schema {
+    query: Query
+}
+type Query {
+  test: %[2]s
+}
+EOF
+}
+
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  source_api_association_config = [{
+    merge_type = "MANUAL_MERGE"
+  }]
+}
+
+resource "aws_appsync_source_api_association_merge" "test" {
+  association_id = aws_appsync_source_api_association.test.association_id
+  merged_api_id  = aws_appsync_source_api_association.test.merged_api_id
+
+  triggers = {
+    schema = aws_appsync_graphql_api.source.schema
+  }
+}
+`, rName, fieldType)
+}
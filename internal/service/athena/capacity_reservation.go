@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_athena_capacity_reservation", name="Capacity Reservation")
+// @Tags(identifierAttribute="arn")
+func newCapacityReservationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &capacityReservationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type capacityReservationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*capacityReservationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_athena_capacity_reservation"
+}
+
+func (r *capacityReservationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allocated_dpus": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CapacityReservationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_dpus": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(24),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_assignment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityAssignmentModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"workgroup_names": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *capacityReservationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &athena.CreateCapacityReservationInput{
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+		TargetDpus: fwflex.Int32FromFramework(ctx, data.TargetDPUs),
+	}
+
+	_, err := conn.CreateCapacityReservation(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, r.capacityReservationARN(ctx, name))
+
+	output, err := waitCapacityReservationActive(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrName), data.Name) // Set 'name' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.ARN)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) create", name), err.Error())
+
+		return
+	}
+
+	if len(data.CapacityAssignments.Elements()) > 0 {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, name, data.CapacityAssignments); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrName), data.Name)
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.ARN)
+			response.Diagnostics.AddError(fmt.Sprintf("putting Athena Capacity Reservation (%s) capacity assignment configuration", name), err.Error())
+
+			return
+		}
+	}
+
+	data.AllocatedDPUs = fwflex.Int32ToFramework(ctx, output.AllocatedDpus)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *capacityReservationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findCapacityReservationByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+
+	data.AllocatedDPUs = fwflex.Int32ToFramework(ctx, output.AllocatedDpus)
+	data.ARN = fwflex.StringValueToFramework(ctx, r.capacityReservationARN(ctx, name))
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.TargetDPUs = fwflex.Int32ToFramework(ctx, output.TargetDpus)
+
+	assignments, err := findCapacityAssignmentsByReservationName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		data.CapacityAssignments = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []capacityAssignmentModel{})
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading Athena Capacity Reservation (%s) capacity assignment configuration", name), err.Error())
+
+		return
+	default:
+		response.Diagnostics.Append(fwflex.Flatten(ctx, assignments, &data.CapacityAssignments)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *capacityReservationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new capacityReservationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := new.Name.ValueString()
+
+	if !new.TargetDPUs.Equal(old.TargetDPUs) {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(name),
+			TargetDpus: fwflex.Int32FromFramework(ctx, new.TargetDPUs),
+		}
+
+		_, err := conn.UpdateCapacityReservation(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Athena Capacity Reservation (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := waitCapacityReservationActive(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) update", name), err.Error())
+
+		return
+	}
+
+	if !new.CapacityAssignments.Equal(old.CapacityAssignments) {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, name, new.CapacityAssignments); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Athena Capacity Reservation (%s) capacity assignment configuration", name), err.Error())
+
+			return
+		}
+	}
+
+	new.AllocatedDPUs = fwflex.Int32ToFramework(ctx, output.AllocatedDpus)
+	new.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *capacityReservationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data capacityReservationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.Name.ValueString()
+
+	// A capacity reservation must be cancelled before it can be deleted.
+	_, err := conn.CancelCapacityReservation(ctx, &athena.CancelCapacityReservationInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return
+	}
+
+	if err != nil && !errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "already cancelled") {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitCapacityReservationCancelled(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena Capacity Reservation (%s) cancel", name), err.Error())
+
+		return
+	}
+
+	_, err = conn.DeleteCapacityReservation(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Athena Capacity Reservation (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *capacityReservationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+func (r *capacityReservationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *capacityReservationResource) capacityReservationARN(ctx context.Context, name string) string {
+	return r.Meta().RegionalARN(ctx, "athena", "capacity-reservation/"+name)
+}
+
+func putCapacityAssignmentConfiguration(ctx context.Context, conn *athena.Client, name string, tfList fwtypes.ListNestedObjectValueOf[capacityAssignmentModel]) error {
+	input := &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     []awstypes.CapacityAssignment{},
+		CapacityReservationName: aws.String(name),
+	}
+
+	if diags := fwflex.Expand(ctx, tfList, &input.CapacityAssignments); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	_, err := conn.PutCapacityAssignmentConfiguration(ctx, input)
+
+	return err
+}
+
+func findCapacityReservationByName(ctx context.Context, conn *athena.Client, name string) (*awstypes.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservation(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.CapacityReservation.Status; status == awstypes.CapacityReservationStatusCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func findCapacityAssignmentsByReservationName(ctx context.Context, conn *athena.Client, name string) ([]awstypes.CapacityAssignment, error) {
+	input := &athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityAssignmentConfiguration(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityAssignmentConfiguration == nil || len(output.CapacityAssignmentConfiguration.CapacityAssignments) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityAssignmentConfiguration.CapacityAssignments, nil
+}
+
+func statusCapacityReservation(ctx context.Context, conn *athena.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &athena.GetCapacityReservationInput{
+			Name: aws.String(name),
+		}
+
+		// Don't use findCapacityReservationByName as it treats CANCELLED as not found.
+		output, err := conn.GetCapacityReservation(ctx, input)
+
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.CapacityReservation == nil {
+			return nil, "", nil
+		}
+
+		return output.CapacityReservation, string(output.CapacityReservation.Status), nil
+	}
+}
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending),
+		Target:  enum.Slice(awstypes.CapacityReservationStatusActive),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		if v := output.LastAllocation; v != nil && output.Status == awstypes.CapacityReservationStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", v.Status, aws.ToString(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Client, name string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationStatusActive, awstypes.CapacityReservationStatusPending, awstypes.CapacityReservationStatusUpdatePending, awstypes.CapacityReservationStatusCancelling),
+		Target:  enum.Slice(awstypes.CapacityReservationStatusCancelled, awstypes.CapacityReservationStatusFailed),
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type capacityReservationResourceModel struct {
+	AllocatedDPUs       types.Int64                                              `tfsdk:"allocated_dpus"`
+	ARN                 types.String                                             `tfsdk:"arn"`
+	CapacityAssignments fwtypes.ListNestedObjectValueOf[capacityAssignmentModel] `tfsdk:"capacity_assignment"`
+	Name                types.String                                             `tfsdk:"name"`
+	Status              fwtypes.StringEnum[awstypes.CapacityReservationStatus]   `tfsdk:"status"`
+	Tags                tftags.Map                                               `tfsdk:"tags"`
+	TagsAll             tftags.Map                                               `tfsdk:"tags_all"`
+	TargetDPUs          types.Int64                                              `tfsdk:"target_dpus"`
+	Timeouts            timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type capacityAssignmentModel struct {
+	WorkGroupNames fwtypes.SetValueOf[types.String] `tfsdk:"workgroup_names"`
+}
@@ -65,6 +65,10 @@ func (r *logicallyAirGappedVaultResource) Schema(ctx context.Context, request re
 			names.AttrID:  framework.IDAttribute(),
 			"max_retention_days": schema.Int64Attribute{
 				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtMost(36500),
+					int64validator.AtLeastSumOf(path.MatchRelative().AtParent().AtName("min_retention_days")),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
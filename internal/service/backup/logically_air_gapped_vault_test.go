@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -145,6 +146,28 @@ func TestAccBackupLogicallyAirGappedVault_tags(t *testing.T) {
 	})
 }
 
+func TestAccBackupLogicallyAirGappedVault_retentionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLogicallyAirGappedVaultConfig_retention(rName, 7, 6),
+				ExpectError: regexache.MustCompile(`value must be at least sum of`),
+			},
+			{
+				Config:      testAccLogicallyAirGappedVaultConfig_retention(rName, 7, 36501),
+				ExpectError: regexache.MustCompile(`value must be at most 36500`),
+			},
+		},
+	})
+}
+
 func testAccCheckLogicallyAirGappedVaultDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
@@ -202,6 +225,16 @@ resource "aws_backup_logically_air_gapped_vault" "test" {
 `, rName)
 }
 
+func testAccLogicallyAirGappedVaultConfig_retention(rName string, minRetentionDays, maxRetentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_backup_logically_air_gapped_vault" "test" {
+  name               = %[1]q
+  max_retention_days = %[3]d
+  min_retention_days = %[2]d
+}
+`, rName, minRetentionDays, maxRetentionDays)
+}
+
 func testAccLogicallyAirGappedVaultConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_backup_logically_air_gapped_vault" "test" {
@@ -22,6 +22,7 @@ func TestAccBedrock_serial(t *testing.T) {
 			"validationDataConfig":                  testAccCustomModel_validationDataConfig,
 			"validationDataConfigWaitForCompletion": testAccCustomModel_validationDataConfigWaitForCompletion,
 			"vpcConfig":                             testAccCustomModel_vpcConfig,
+			"waitForCompletion":                     testAccCustomModel_waitForCompletion,
 			"singularDataSourceBasic":               testAccCustomModelDataSource_basic,
 			"pluralDataSourceBasic":                 testAccCustomModelsDataSource_basic,
 		},
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -46,6 +47,7 @@ import (
 func newCustomModelResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &customModelResource{}
 
+	r.SetDefaultCreateTimeout(240 * time.Minute)
 	r.SetDefaultDeleteTimeout(120 * time.Minute)
 
 	return r, nil
@@ -139,6 +141,11 @@ func (r *customModelResource) Schema(ctx context.Context, request resource.Schem
 			names.AttrTagsAll:    tftags.TagsAttributeComputedOnly(),
 			"training_metrics":   framework.ResourceComputedListOfObjectAttribute[trainingMetricsModel](ctx),
 			"validation_metrics": framework.ResourceComputedListOfObjectAttribute[validatorMetricModel](ctx),
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"output_data_config": schema.ListNestedBlock{
@@ -311,6 +318,39 @@ func (r *customModelResource) Create(ctx context.Context, request resource.Creat
 	data.ValidationMetrics = fwtypes.NewListNestedObjectValueOfNull[validatorMetricModel](ctx)
 	data.setID()
 
+	if data.WaitForCompletion.ValueBool() {
+		job, err := waitModelCustomizationJobCompleted(ctx, conn, jobARN, r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			// Save the job so that it can be stopped on destroy.
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) complete", jobARN), err.Error())
+
+			return
+		}
+
+		customModelARN := aws.ToString(job.OutputModelArn)
+		outputGM, err := findCustomModelByID(ctx, conn, customModelARN)
+
+		if err != nil {
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", customModelARN), err.Error())
+
+			return
+		}
+
+		var dataFromGetCustomModel customModelResourceModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, outputGM, &dataFromGetCustomModel)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.CustomModelARN = fwflex.StringToFramework(ctx, outputGM.ModelArn)
+		data.JobStatus = fwtypes.StringEnumValue(job.Status)
+		data.TrainingMetrics = dataFromGetCustomModel.TrainingMetrics
+		data.ValidationMetrics = dataFromGetCustomModel.ValidationMetrics
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -350,6 +390,11 @@ func (r *customModelResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
+	// wait_for_completion is not returned by the API.
+	if data.WaitForCompletion.IsNull() {
+		data.WaitForCompletion = types.BoolValue(false)
+	}
+
 	// Some fields in GetModelCustomizationJobOutput have different names than in CreateModelCustomizationJobInput.
 	data.CustomModelKmsKeyID = fwflex.StringToFrameworkARN(ctx, outputGJ.OutputModelKmsKeyArn)
 	data.CustomModelName = fwflex.StringToFramework(ctx, outputGJ.OutputModelName)
@@ -411,7 +456,7 @@ func (r *customModelResource) Update(ctx context.Context, request resource.Updat
 		return
 	}
 
-	// Update is only called when `tags` are updated.
+	// Update is only called when `tags` or `wait_for_completion` are updated.
 	// Set unknowns to the old (in state) values.
 	new.CustomModelARN = old.CustomModelARN
 	new.JobStatus = old.JobStatus
@@ -621,6 +666,7 @@ type customModelResourceModel struct {
 	ValidationDataConfig fwtypes.ListNestedObjectValueOf[validationDataConfigModel] `tfsdk:"validation_data_config"`
 	ValidationMetrics    fwtypes.ListNestedObjectValueOf[validatorMetricModel]      `tfsdk:"validation_metrics"`
 	VPCConfig            fwtypes.ListNestedObjectValueOf[vpcConfigModel]            `tfsdk:"vpc_config"`
+	WaitForCompletion    types.Bool                                                 `tfsdk:"wait_for_completion"`
 }
 
 func (data *customModelResourceModel) InitFromID() error {
@@ -60,6 +60,7 @@ func testAccCustomModel_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "validation_data_config.#", "0"),
 					resource.TestCheckNoResourceAttr(resourceName, "validation_metrics"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
 				),
 			},
 			{
@@ -72,6 +73,39 @@ func testAccCustomModel_basic(t *testing.T) {
 	})
 }
 
+func testAccCustomModel_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_custom_model.test"
+	var v bedrock.GetModelCustomizationJobOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomModelConfig_waitForCompletion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_model_arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_status", "Completed"),
+					resource.TestCheckResourceAttr(resourceName, "training_metrics.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "training_metrics.0.training_loss"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"base_model_identifier", "wait_for_completion"},
+			},
+		},
+	})
+}
+
 func testAccCustomModel_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -497,6 +531,34 @@ resource "aws_bedrock_custom_model" "test" {
 `, rName))
 }
 
+func testAccCustomModelConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(testAccCustomModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrock_custom_model" "test" {
+  custom_model_name     = %[1]q
+  job_name              = %[1]q
+  base_model_identifier = data.aws_bedrock_foundation_model.test.model_arn
+  role_arn              = aws_iam_role.test.arn
+
+  hyperparameters = {
+    "epochCount"              = "1"
+    "batchSize"               = "1"
+    "learningRate"            = "0.005"
+    "learningRateWarmupSteps" = "0"
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.output.id}/data/"
+  }
+
+  training_data_config {
+    s3_uri = "s3://${aws_s3_bucket.training.id}/data/train.jsonl"
+  }
+
+  wait_for_completion = true
+}
+`, rName))
+}
+
 func testAccCustomModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccCustomModelConfig_base(rName), fmt.Sprintf(`
 resource "aws_bedrock_custom_model" "test" {
@@ -171,7 +171,7 @@ func (r *resourceGuardrail) Schema(ctx context.Context, req resource.SchemaReque
 									"threshold": schema.Float64Attribute{
 										Required: true,
 										Validators: []validator.Float64{
-											float64validator.AtLeast(filtersConfigThresholdMin),
+											float64validator.Between(filtersConfigThresholdMin, filtersConfigThresholdMax),
 										},
 									},
 									names.AttrType: schema.StringAttribute{
@@ -337,7 +337,8 @@ func (r *resourceGuardrail) Schema(ctx context.Context, req resource.SchemaReque
 }
 
 const (
-	filtersConfigThresholdMin = 0.000000
+	filtersConfigThresholdMin = 0.0
+	filtersConfigThresholdMax = 0.99
 
 	guardrailIDParts = 2
 )
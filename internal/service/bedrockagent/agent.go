@@ -423,7 +423,10 @@ func prepareAgent(ctx context.Context, conn *bedrockagent.Client, id string, tim
 		AgentId: aws.String(id),
 	}
 
-	_, err := conn.PrepareAgent(ctx, input)
+	// Action groups and knowledge base associations on the same agent may prepare it concurrently.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.PrepareAgent(ctx, input)
+	})
 
 	if err != nil {
 		return nil, fmt.Errorf("preparing Bedrock Agent (%s): %w", id, err)
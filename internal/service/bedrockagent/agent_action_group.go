@@ -255,7 +255,10 @@ func (r *agentActionGroupResource) Create(ctx context.Context, request resource.
 		return
 	}
 
-	output, err := conn.CreateAgentActionGroup(ctx, input)
+	// The agent cannot be modified while another action group is preparing it.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.CreateTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.CreateAgentActionGroup(ctx, input)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("creating Bedrock Agent Action Group", err.Error())
@@ -263,6 +266,8 @@ func (r *agentActionGroupResource) Create(ctx context.Context, request resource.
 		return
 	}
 
+	output := outputRaw.(*bedrockagent.CreateAgentActionGroupOutput)
+
 	// Set values for unknowns.
 	data.ActionGroupID = fwflex.StringToFramework(ctx, output.AgentActionGroup.ActionGroupId)
 	data.ActionGroupState = fwtypes.StringEnumValue(output.AgentActionGroup.ActionGroupState)
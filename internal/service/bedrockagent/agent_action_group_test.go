@@ -280,6 +280,33 @@ func TestAccBedrockAgentAgentActionGroup_ActionGroupExecutor_customControl(t *te
 	})
 }
 
+func TestAccBedrockAgentAgentActionGroup_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+	var v awstypes.AgentActionGroup
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_multiple(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName+".0", &v),
+					testAccCheckAgentActionGroupExists(ctx, resourceName+".1", &v),
+					testAccCheckAgentActionGroupExists(ctx, resourceName+".2", &v),
+					resource.TestCheckResourceAttr(resourceName+".0", "prepare_agent", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName+".1", "prepare_agent", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName+".2", "prepare_agent", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAgentActionGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
@@ -346,6 +373,28 @@ resource "aws_bedrockagent_agent_action_group" "test" {
 `, rName))
 }
 
+func testAccAgentActionGroupConfig_multiple(rName string, count int) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "anthropic.claude-v2", "basic claude"),
+		testAccAgentActionGroupConfig_lambda(rName),
+		fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  count = %[2]d
+
+  action_group_name          = "%[1]s-${count.index}"
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  agent_version              = "DRAFT"
+  description                = "Basic Agent Action"
+  skip_resource_in_use_check = true
+  action_group_executor {
+    lambda = aws_lambda_function.test_lambda.arn
+  }
+  api_schema {
+    payload = file("${path.module}/test-fixtures/api_schema.yaml")
+  }
+}
+`, rName, count))
+}
+
 func testAccAgentActionGroupConfig_APISchema_s3(rName string) string {
 	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "anthropic.claude-v2", "basic claude"),
 		testAccAgentActionGroupConfig_lambda(rName),
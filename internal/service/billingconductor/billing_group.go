@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_billingconductor_billing_group", name="Billing Group")
+// @Tags(identifierAttribute="arn")
+func newBillingGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &billingGroupResource{}, nil
+}
+
+type billingGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*billingGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_billingconductor_billing_group"
+}
+
+func (r *billingGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_\+=\.\-@]+$`), ""),
+				},
+			},
+			"primary_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrSize: schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BillingGroupStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusReason: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"account_grouping": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accountGroupingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_associate": schema.BoolAttribute{
+							Optional: true,
+						},
+						"linked_account_ids": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(30),
+								setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+							},
+						},
+					},
+				},
+			},
+			"computation_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computationPreferenceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"pricing_plan_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *billingGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data billingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	input := billingconductor.CreateBillingGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateBillingGroup(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Billing Conductor Billing Group (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Arn)
+
+	billingGroup, err := findBillingGroupByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	accountIDs, err := findLinkedAccountIDsByBillingGroupARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s) linked accounts", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, billingGroup.Arn)
+	data.Size = types.Int64Value(billingGroup.Size)
+	data.Status = fwtypes.StringEnumValue(billingGroup.Status)
+	data.StatusReason = fwflex.StringToFramework(ctx, billingGroup.StatusReason)
+	response.Diagnostics.Append(data.setLinkedAccountIDs(ctx, accountIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *billingGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data billingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	output, err := findBillingGroupByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The list element's account grouping only carries auto_associate.
+	accountGrouping := data.AccountGrouping
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.AccountGrouping = accountGrouping
+
+	accountIDs, err := findLinkedAccountIDsByBillingGroupARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s) linked accounts", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var autoAssociate *bool
+	if output.AccountGrouping != nil {
+		autoAssociate = output.AccountGrouping.AutoAssociate
+	}
+
+	response.Diagnostics.Append(data.setAccountGrouping(ctx, autoAssociate, accountIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *billingGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new billingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	oldAccountGrouping, diags := old.AccountGrouping.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newAccountGrouping, diags := new.AccountGrouping.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.ComputationPreference.Equal(old.ComputationPreference) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!newAccountGrouping.AutoAssociate.Equal(oldAccountGrouping.AutoAssociate) {
+		input := billingconductor.UpdateBillingGroupInput{
+			AccountGrouping: &awstypes.UpdateBillingGroupAccountGrouping{
+				AutoAssociate: fwflex.BoolFromFramework(ctx, newAccountGrouping.AutoAssociate),
+			},
+			Arn:         fwflex.StringFromFramework(ctx, new.ID),
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.ComputationPreference, &input.ComputationPreference)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateBillingGroup(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Billing Conductor Billing Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !newAccountGrouping.LinkedAccountIDs.IsUnknown() && !newAccountGrouping.LinkedAccountIDs.Equal(oldAccountGrouping.LinkedAccountIDs) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, oldAccountGrouping.LinkedAccountIDs), fwflex.ExpandFrameworkStringValueSet(ctx, newAccountGrouping.LinkedAccountIDs)
+		add, del := n.Difference(o), o.Difference(n)
+
+		if len(del) > 0 {
+			input := billingconductor.DisassociateAccountsInput{
+				AccountIds: del,
+				Arn:        fwflex.StringFromFramework(ctx, new.ID),
+			}
+
+			_, err := conn.DisassociateAccounts(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Billing Conductor Billing Group (%s) accounts", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			input := billingconductor.AssociateAccountsInput{
+				AccountIds: add,
+				Arn:        fwflex.StringFromFramework(ctx, new.ID),
+			}
+
+			_, err := conn.AssociateAccounts(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Billing Conductor Billing Group (%s) accounts", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	billingGroup, err := findBillingGroupByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	accountIDs, err := findLinkedAccountIDsByBillingGroupARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Billing Group (%s) linked accounts", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.Size = types.Int64Value(billingGroup.Size)
+	new.Status = fwtypes.StringEnumValue(billingGroup.Status)
+	new.StatusReason = fwflex.StringToFramework(ctx, billingGroup.StatusReason)
+	response.Diagnostics.Append(new.setLinkedAccountIDs(ctx, accountIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *billingGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data billingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	_, err := conn.DeleteBillingGroup(ctx, &billingconductor.DeleteBillingGroupInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Billing Conductor Billing Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *billingGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findBillingGroupByARN(ctx context.Context, conn *billingconductor.Client, arn string) (*awstypes.BillingGroupListElement, error) {
+	input := &billingconductor.ListBillingGroupsInput{
+		Filters: &awstypes.ListBillingGroupsFilter{
+			Arns: []string{arn},
+		},
+	}
+
+	return findBillingGroup(ctx, conn, input)
+}
+
+func findBillingGroup(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListBillingGroupsInput) (*awstypes.BillingGroupListElement, error) {
+	output, err := findBillingGroups(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findBillingGroups(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListBillingGroupsInput) ([]awstypes.BillingGroupListElement, error) {
+	var output []awstypes.BillingGroupListElement
+
+	pages := billingconductor.NewListBillingGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.BillingGroups...)
+	}
+
+	return output, nil
+}
+
+func findLinkedAccountIDsByBillingGroupARN(ctx context.Context, conn *billingconductor.Client, arn string) ([]string, error) {
+	input := &billingconductor.ListAccountAssociationsInput{
+		Filters: &awstypes.ListAccountAssociationsFilter{
+			Association: aws.String(arn),
+		},
+	}
+	var output []string
+
+	pages := billingconductor.NewListAccountAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LinkedAccounts {
+			output = append(output, aws.ToString(v.AccountId))
+		}
+	}
+
+	return output, nil
+}
+
+type billingGroupResourceModel struct {
+	AccountGrouping       fwtypes.ListNestedObjectValueOf[accountGroupingModel]       `tfsdk:"account_grouping"`
+	ARN                   types.String                                                `tfsdk:"arn"`
+	ComputationPreference fwtypes.ListNestedObjectValueOf[computationPreferenceModel] `tfsdk:"computation_preference"`
+	Description           types.String                                                `tfsdk:"description"`
+	ID                    types.String                                                `tfsdk:"id"`
+	Name                  types.String                                                `tfsdk:"name"`
+	PrimaryAccountID      types.String                                                `tfsdk:"primary_account_id"`
+	Size                  types.Int64                                                 `tfsdk:"size"`
+	Status                fwtypes.StringEnum[awstypes.BillingGroupStatus]             `tfsdk:"status"`
+	StatusReason          types.String                                                `tfsdk:"status_reason"`
+	Tags                  tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+func (data *billingGroupResourceModel) setLinkedAccountIDs(ctx context.Context, accountIDs []string) diag.Diagnostics {
+	accountGrouping, diags := data.AccountGrouping.ToPtr(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	var autoAssociate *bool
+	if accountGrouping != nil {
+		autoAssociate = fwflex.BoolFromFramework(ctx, accountGrouping.AutoAssociate)
+	}
+
+	return data.setAccountGrouping(ctx, autoAssociate, accountIDs)
+}
+
+func (data *billingGroupResourceModel) setAccountGrouping(ctx context.Context, autoAssociate *bool, accountIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountGrouping := &accountGroupingModel{
+		AutoAssociate:    fwflex.BoolToFramework(ctx, autoAssociate),
+		LinkedAccountIDs: fwflex.FlattenFrameworkStringValueSet(ctx, accountIDs),
+	}
+
+	if current, d := data.AccountGrouping.ToPtr(ctx); !d.HasError() && current != nil && current.AutoAssociate.IsNull() && !aws.ToBool(autoAssociate) {
+		// Preserve an omitted auto_associate rather than reporting a diff for the default value.
+		accountGrouping.AutoAssociate = current.AutoAssociate
+	}
+
+	data.AccountGrouping, diags = fwtypes.NewListNestedObjectValueOfPtr(ctx, accountGrouping)
+
+	return diags
+}
+
+type accountGroupingModel struct {
+	AutoAssociate    types.Bool `tfsdk:"auto_associate"`
+	LinkedAccountIDs types.Set  `tfsdk:"linked_account_ids"`
+}
+
+type computationPreferenceModel struct {
+	PricingPlanARN fwtypes.ARN `tfsdk:"pricing_plan_arn"`
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorBillingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_billing_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryAccountID := acctest.SkipIfEnvVarNotSet(t, "AWS_BILLINGCONDUCTOR_PRIMARY_ACCOUNT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBillingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupConfig_basic(rName, primaryAccountID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.linked_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "account_grouping.0.linked_account_ids.*", primaryAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "computation_preference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "computation_preference.0.pricing_plan_arn", "aws_billingconductor_pricing_plan.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "primary_account_id", primaryAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSize, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillingGroupConfig_basic(rName, primaryAccountID, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorBillingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_billing_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryAccountID := acctest.SkipIfEnvVarNotSet(t, "AWS_BILLINGCONDUCTOR_PRIMARY_ACCOUNT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBillingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupConfig_basic(rName, primaryAccountID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourceBillingGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBillingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_billing_group" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindBillingGroupByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Billing Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBillingGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		_, err := tfbillingconductor.FindBillingGroupByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccBillingGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_rule" "test" {
+  name                = %[1]q
+  scope               = "GLOBAL"
+  type                = "MARKUP"
+  modifier_percentage = 10
+}
+
+resource "aws_billingconductor_pricing_plan" "test" {
+  name              = %[1]q
+  pricing_rule_arns = [aws_billingconductor_pricing_rule.test.arn]
+}
+`, rName)
+}
+
+func testAccBillingGroupConfig_basic(rName, primaryAccountID, description string) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_billingconductor_billing_group" "test" {
+  name               = %[1]q
+  description        = %[3]q
+  primary_account_id = %[2]q
+
+  account_grouping {
+    linked_account_ids = [%[2]q]
+  }
+
+  computation_preference {
+    pricing_plan_arn = aws_billingconductor_pricing_plan.test.arn
+  }
+}
+`, rName, primaryAccountID, description))
+}
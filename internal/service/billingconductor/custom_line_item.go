@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_billingconductor_custom_line_item", name="Custom Line Item")
+// @Tags(identifierAttribute="arn")
+func newCustomLineItemResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &customLineItemResource{}, nil
+}
+
+type customLineItemResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*customLineItemResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_billingconductor_custom_line_item"
+}
+
+func (r *customLineItemResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	billingPeriodValidators := []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^\d{4}-(0?[1-9]|1[012])$`), "must be in the format YYYY-MM"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"association_size": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_group_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"currency_code": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CurrencyCode](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_\+=\.\-@]+$`), ""),
+				},
+			},
+			"product_code": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"billing_period_range": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLineItemBillingPeriodRangeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"exclusive_end_billing_period": schema.StringAttribute{
+							Optional:   true,
+							Validators: billingPeriodValidators,
+						},
+						"inclusive_start_billing_period": schema.StringAttribute{
+							Required:   true,
+							Validators: billingPeriodValidators,
+						},
+					},
+				},
+			},
+			"charge_details": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLineItemChargeDetailsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CustomLineItemType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"flat": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLineItemFlatChargeDetailsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("flat"),
+									path.MatchRelative().AtParent().AtName("percentage"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"charge_value": schema.Float64Attribute{
+										Required: true,
+										Validators: []validator.Float64{
+											float64validator.Between(0, 1000000),
+										},
+									},
+								},
+							},
+						},
+						"percentage": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLineItemPercentageChargeDetailsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"associated_values": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
+									"percentage_value": schema.Float64Attribute{
+										Required: true,
+										Validators: []validator.Float64{
+											float64validator.Between(0, 10000),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *customLineItemResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customLineItemResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	input := billingconductor.CreateCustomLineItemInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCustomLineItem(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Billing Conductor Custom Line Item (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Arn)
+
+	customLineItem, err := findCustomLineItemByARN(ctx, conn, data.ID.ValueString(), data.billingPeriod(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Custom Line Item (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, customLineItem.Arn)
+	data.AssociationSize = types.Int64Value(customLineItem.AssociationSize)
+	data.CurrencyCode = fwtypes.StringEnumValue(customLineItem.CurrencyCode)
+	data.ProductCode = fwflex.StringToFramework(ctx, customLineItem.ProductCode)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *customLineItemResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customLineItemResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	output, err := findCustomLineItemByARN(ctx, conn, data.ID.ValueString(), data.billingPeriod(ctx))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Custom Line Item (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The list element's percentage charge details don't include the associated values.
+	var associatedValues types.List
+	if chargeDetails, diags := data.ChargeDetails.ToPtr(ctx); !diags.HasError() && chargeDetails != nil {
+		if percentage, diags := chargeDetails.Percentage.ToPtr(ctx); !diags.HasError() && percentage != nil {
+			associatedValues = percentage.AssociatedValues
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !associatedValues.IsNull() {
+		if chargeDetails, diags := data.ChargeDetails.ToPtr(ctx); !diags.HasError() && chargeDetails != nil {
+			if percentage, diags := chargeDetails.Percentage.ToPtr(ctx); !diags.HasError() && percentage != nil {
+				percentage.AssociatedValues = associatedValues
+				chargeDetails.Percentage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, percentage)
+				data.ChargeDetails = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, chargeDetails)
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customLineItemResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new customLineItemResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	if !new.ChargeDetails.Equal(old.ChargeDetails) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) {
+		input := billingconductor.UpdateCustomLineItemInput{
+			Arn:         fwflex.StringFromFramework(ctx, new.ID),
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.BillingPeriodRange, &input.BillingPeriodRange)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		chargeDetails, diags := new.ChargeDetails.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.ChargeDetails = &awstypes.UpdateCustomLineItemChargeDetails{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, chargeDetails.Flat, &input.ChargeDetails.Flat)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, chargeDetails.Percentage, &input.ChargeDetails.Percentage)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCustomLineItem(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Billing Conductor Custom Line Item (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	customLineItem, err := findCustomLineItemByARN(ctx, conn, new.ID.ValueString(), new.billingPeriod(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Custom Line Item (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.AssociationSize = types.Int64Value(customLineItem.AssociationSize)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *customLineItemResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customLineItemResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	input := billingconductor.DeleteCustomLineItemInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.BillingPeriodRange, &input.BillingPeriodRange)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteCustomLineItem(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Billing Conductor Custom Line Item (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *customLineItemResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCustomLineItemByARN(ctx context.Context, conn *billingconductor.Client, arn, billingPeriod string) (*awstypes.CustomLineItemListElement, error) {
+	input := &billingconductor.ListCustomLineItemsInput{
+		Filters: &awstypes.ListCustomLineItemsFilter{
+			Arns: []string{arn},
+		},
+	}
+	if billingPeriod != "" {
+		input.BillingPeriod = aws.String(billingPeriod)
+	}
+
+	return findCustomLineItem(ctx, conn, input)
+}
+
+func findCustomLineItem(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListCustomLineItemsInput) (*awstypes.CustomLineItemListElement, error) {
+	output, err := findCustomLineItems(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCustomLineItems(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListCustomLineItemsInput) ([]awstypes.CustomLineItemListElement, error) {
+	var output []awstypes.CustomLineItemListElement
+
+	pages := billingconductor.NewListCustomLineItemsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CustomLineItems...)
+	}
+
+	return output, nil
+}
+
+type customLineItemResourceModel struct {
+	AccountID          types.String                                                           `tfsdk:"account_id"`
+	ARN                types.String                                                           `tfsdk:"arn"`
+	AssociationSize    types.Int64                                                            `tfsdk:"association_size"`
+	BillingGroupARN    fwtypes.ARN                                                            `tfsdk:"billing_group_arn"`
+	BillingPeriodRange fwtypes.ListNestedObjectValueOf[customLineItemBillingPeriodRangeModel] `tfsdk:"billing_period_range"`
+	ChargeDetails      fwtypes.ListNestedObjectValueOf[customLineItemChargeDetailsModel]      `tfsdk:"charge_details"`
+	CurrencyCode       fwtypes.StringEnum[awstypes.CurrencyCode]                              `tfsdk:"currency_code"`
+	Description        types.String                                                           `tfsdk:"description"`
+	ID                 types.String                                                           `tfsdk:"id"`
+	Name               types.String                                                           `tfsdk:"name"`
+	ProductCode        types.String                                                           `tfsdk:"product_code"`
+	Tags               tftags.Map                                                             `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                             `tfsdk:"tags_all"`
+}
+
+// billingPeriod returns the billing period in which the custom line item is looked up.
+// Custom line items are only listed for the current billing period unless another is requested.
+func (data *customLineItemResourceModel) billingPeriod(ctx context.Context) string {
+	billingPeriodRange, diags := data.BillingPeriodRange.ToPtr(ctx)
+	if diags.HasError() || billingPeriodRange == nil {
+		return ""
+	}
+
+	return billingPeriodRange.InclusiveStartBillingPeriod.ValueString()
+}
+
+type customLineItemBillingPeriodRangeModel struct {
+	ExclusiveEndBillingPeriod   types.String `tfsdk:"exclusive_end_billing_period"`
+	InclusiveStartBillingPeriod types.String `tfsdk:"inclusive_start_billing_period"`
+}
+
+type customLineItemChargeDetailsModel struct {
+	Flat       fwtypes.ListNestedObjectValueOf[customLineItemFlatChargeDetailsModel]       `tfsdk:"flat"`
+	Percentage fwtypes.ListNestedObjectValueOf[customLineItemPercentageChargeDetailsModel] `tfsdk:"percentage"`
+	Type       fwtypes.StringEnum[awstypes.CustomLineItemType]                             `tfsdk:"type"`
+}
+
+type customLineItemFlatChargeDetailsModel struct {
+	ChargeValue types.Float64 `tfsdk:"charge_value"`
+}
+
+type customLineItemPercentageChargeDetailsModel struct {
+	AssociatedValues types.List    `tfsdk:"associated_values"`
+	PercentageValue  types.Float64 `tfsdk:"percentage_value"`
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorCustomLineItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_custom_line_item.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryAccountID := acctest.SkipIfEnvVarNotSet(t, "AWS_BILLINGCONDUCTOR_PRIMARY_ACCOUNT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLineItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLineItemConfig_basic(rName, primaryAccountID, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "billing_group_arn", "aws_billingconductor_billing_group.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.0.charge_value", "100"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.type", "FEE"),
+					resource.TestCheckResourceAttr(resourceName, "currency_code", "USD"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomLineItemConfig_basic(rName, primaryAccountID, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.0.charge_value", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorCustomLineItem_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_custom_line_item.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryAccountID := acctest.SkipIfEnvVarNotSet(t, "AWS_BILLINGCONDUCTOR_PRIMARY_ACCOUNT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLineItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLineItemConfig_basic(rName, primaryAccountID, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourceCustomLineItem, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomLineItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_custom_line_item" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindCustomLineItemByARN(ctx, conn, rs.Primary.ID, "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Custom Line Item %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomLineItemExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		_, err := tfbillingconductor.FindCustomLineItemByARN(ctx, conn, rs.Primary.ID, "")
+
+		return err
+	}
+}
+
+func testAccCustomLineItemConfig_basic(rName, primaryAccountID string, chargeValue int) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_basic(rName, primaryAccountID, rName), fmt.Sprintf(`
+resource "aws_billingconductor_custom_line_item" "test" {
+  name              = %[1]q
+  billing_group_arn = aws_billingconductor_billing_group.test.arn
+
+  charge_details {
+    type = "FEE"
+
+    flat {
+      charge_value = %[2]d
+    }
+  }
+}
+`, rName, chargeValue))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+// Exports for use in tests only.
+var (
+	ResourceBillingGroup   = newBillingGroupResource
+	ResourceCustomLineItem = newCustomLineItemResource
+	ResourcePricingPlan    = newPricingPlanResource
+	ResourcePricingRule    = newPricingRuleResource
+
+	FindBillingGroupByARN   = findBillingGroupByARN
+	FindCustomLineItemByARN = findCustomLineItemByARN
+	FindPricingPlanByARN    = findPricingPlanByARN
+	FindPricingRuleByARN    = findPricingRuleByARN
+)
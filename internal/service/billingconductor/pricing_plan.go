@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_billingconductor_pricing_plan", name="Pricing Plan")
+// @Tags(identifierAttribute="arn")
+func newPricingPlanResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &pricingPlanResource{}, nil
+}
+
+type pricingPlanResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*pricingPlanResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_billingconductor_pricing_plan"
+}
+
+func (r *pricingPlanResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_\+=\.\-@]+$`), ""),
+				},
+			},
+			"pricing_rule_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(30),
+					setvalidator.ValueStringsAre(fwvalidators.ARN()),
+				},
+			},
+			names.AttrSize: schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *pricingPlanResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	input := billingconductor.CreatePricingPlanInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePricingPlan(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Billing Conductor Pricing Plan (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Arn)
+
+	pricingPlan, err := findPricingPlanByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Plan (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	pricingRuleARNs, err := findPricingRuleARNsByPricingPlanARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Plan (%s) pricing rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, pricingPlan.Arn)
+	data.PricingRuleARNs = fwflex.FlattenFrameworkStringValueSet(ctx, pricingRuleARNs)
+	data.Size = types.Int64Value(pricingPlan.Size)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pricingPlanResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	output, err := findPricingPlanByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Plan (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pricingRuleARNs, err := findPricingRuleARNsByPricingPlanARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Plan (%s) pricing rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.PricingRuleARNs = fwflex.FlattenFrameworkStringValueSet(ctx, pricingRuleARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pricingPlanResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pricingPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := billingconductor.UpdatePricingPlanInput{
+			Arn:         fwflex.StringFromFramework(ctx, new.ID),
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdatePricingPlan(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Billing Conductor Pricing Plan (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.PricingRuleARNs.IsUnknown() && !new.PricingRuleARNs.Equal(old.PricingRuleARNs) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.PricingRuleARNs), fwflex.ExpandFrameworkStringValueSet(ctx, new.PricingRuleARNs)
+		add, del := n.Difference(o), o.Difference(n)
+
+		if len(add) > 0 {
+			input := billingconductor.AssociatePricingRulesInput{
+				Arn:             fwflex.StringFromFramework(ctx, new.ID),
+				PricingRuleArns: add,
+			}
+
+			_, err := conn.AssociatePricingRules(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Billing Conductor Pricing Plan (%s) pricing rules", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		if len(del) > 0 {
+			input := billingconductor.DisassociatePricingRulesInput{
+				Arn:             fwflex.StringFromFramework(ctx, new.ID),
+				PricingRuleArns: del,
+			}
+
+			_, err := conn.DisassociatePricingRules(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Billing Conductor Pricing Plan (%s) pricing rules", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	pricingPlan, err := findPricingPlanByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Plan (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.Size = types.Int64Value(pricingPlan.Size)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pricingPlanResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	_, err := conn.DeletePricingPlan(ctx, &billingconductor.DeletePricingPlanInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Billing Conductor Pricing Plan (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pricingPlanResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPricingPlanByARN(ctx context.Context, conn *billingconductor.Client, arn string) (*awstypes.PricingPlanListElement, error) {
+	input := &billingconductor.ListPricingPlansInput{
+		Filters: &awstypes.ListPricingPlansFilter{
+			Arns: []string{arn},
+		},
+	}
+
+	return findPricingPlan(ctx, conn, input)
+}
+
+func findPricingPlan(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListPricingPlansInput) (*awstypes.PricingPlanListElement, error) {
+	output, err := findPricingPlans(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPricingPlans(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListPricingPlansInput) ([]awstypes.PricingPlanListElement, error) {
+	var output []awstypes.PricingPlanListElement
+
+	pages := billingconductor.NewListPricingPlansPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PricingPlans...)
+	}
+
+	return output, nil
+}
+
+func findPricingRuleARNsByPricingPlanARN(ctx context.Context, conn *billingconductor.Client, arn string) ([]string, error) {
+	input := &billingconductor.ListPricingRulesAssociatedToPricingPlanInput{
+		PricingPlanArn: aws.String(arn),
+	}
+	var output []string
+
+	pages := billingconductor.NewListPricingRulesAssociatedToPricingPlanPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PricingRuleArns...)
+	}
+
+	return output, nil
+}
+
+type pricingPlanResourceModel struct {
+	ARN             types.String `tfsdk:"arn"`
+	Description     types.String `tfsdk:"description"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	PricingRuleARNs types.Set    `tfsdk:"pricing_rule_arns"`
+	Size            types.Int64  `tfsdk:"size"`
+	Tags            tftags.Map   `tfsdk:"tags"`
+	TagsAll         tftags.Map   `tfsdk:"tags_all"`
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorPricingPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_pricing_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_basic(rName, "aws_billingconductor_pricing_rule.test1.arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_rule_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pricing_rule_arns.*", "aws_billingconductor_pricing_rule.test1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSize, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPricingPlanConfig_basic(rName, "aws_billingconductor_pricing_rule.test2.arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_rule_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pricing_rule_arns.*", "aws_billingconductor_pricing_rule.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorPricingPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_billingconductor_pricing_plan.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_basic(rName, "aws_billingconductor_pricing_rule.test1.arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourcePricingPlan, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPricingPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_pricing_plan" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindPricingPlanByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Pricing Plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPricingPlanExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorClient(ctx)
+
+		_, err := tfbillingconductor.FindPricingPlanByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPricingPlanConfig_basic(rName, pricingRuleARN string) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_rule" "test1" {
+  name                = "%[1]s-1"
+  scope               = "GLOBAL"
+  type                = "MARKUP"
+  modifier_percentage = 10
+}
+
+resource "aws_billingconductor_pricing_rule" "test2" {
+  name                = "%[1]s-2"
+  scope               = "GLOBAL"
+  type                = "DISCOUNT"
+  modifier_percentage = 5
+}
+
+resource "aws_billingconductor_pricing_plan" "test" {
+  name              = %[1]q
+  pricing_rule_arns = [%[2]s]
+}
+`, rName, pricingRuleARN)
+}
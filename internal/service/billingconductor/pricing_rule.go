@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_billingconductor_pricing_rule", name="Pricing Rule")
+// @Tags(identifierAttribute="arn")
+func newPricingRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &pricingRuleResource{}, nil
+}
+
+type pricingRuleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*pricingRuleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_billingconductor_pricing_rule"
+}
+
+func (r *pricingRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associated_pricing_plan_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_entity": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"modifier_percentage": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_\+=\.\-@]+$`), ""),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrScope: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PricingRuleScope](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PricingRuleType](),
+				Required:   true,
+			},
+			"usage_type": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tiering": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pricingRuleTieringModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"free_tier": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pricingRuleFreeTierModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"activated": schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pricingRuleResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// Markup and discount rules apply a percentage; tiering rules configure the free tier.
+		resourcevalidator.Conflicting(
+			path.MatchRoot("modifier_percentage"),
+			path.MatchRoot("tiering"),
+		),
+	}
+}
+
+func (r *pricingRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pricingRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	input := billingconductor.CreatePricingRuleInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePricingRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Billing Conductor Pricing Rule (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Arn)
+
+	pricingRule, err := findPricingRuleByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, pricingRule.Arn)
+	data.AssociatedPricingPlanCount = types.Int64Value(pricingRule.AssociatedPricingPlanCount)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pricingRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pricingRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	output, err := findPricingRuleByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Billing Conductor Pricing Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pricingRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pricingRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.ModifierPercentage.Equal(old.ModifierPercentage) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Tiering.Equal(old.Tiering) ||
+		!new.Type.Equal(old.Type) {
+		input := billingconductor.UpdatePricingRuleInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Arn = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdatePricingRule(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Billing Conductor Pricing Rule (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.AssociatedPricingPlanCount = types.Int64Value(output.AssociatedPricingPlanCount)
+	} else {
+		new.AssociatedPricingPlanCount = old.AssociatedPricingPlanCount
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pricingRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pricingRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingConductorClient(ctx)
+
+	_, err := conn.DeletePricingRule(ctx, &billingconductor.DeletePricingRuleInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Billing Conductor Pricing Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pricingRuleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPricingRuleByARN(ctx context.Context, conn *billingconductor.Client, arn string) (*awstypes.PricingRuleListElement, error) {
+	input := &billingconductor.ListPricingRulesInput{
+		Filters: &awstypes.ListPricingRulesFilter{
+			Arns: []string{arn},
+		},
+	}
+
+	return findPricingRule(ctx, conn, input)
+}
+
+func findPricingRule(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListPricingRulesInput) (*awstypes.PricingRuleListElement, error) {
+	output, err := findPricingRules(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPricingRules(ctx context.Context, conn *billingconductor.Client, input *billingconductor.ListPricingRulesInput) ([]awstypes.PricingRuleListElement, error) {
+	var output []awstypes.PricingRuleListElement
+
+	pages := billingconductor.NewListPricingRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PricingRules...)
+	}
+
+	return output, nil
+}
+
+type pricingRuleResourceModel struct {
+	ARN                        types.String                                             `tfsdk:"arn"`
+	AssociatedPricingPlanCount types.Int64                                              `tfsdk:"associated_pricing_plan_count"`
+	BillingEntity              types.String                                             `tfsdk:"billing_entity"`
+	Description                types.String                                             `tfsdk:"description"`
+	ID                         types.String                                             `tfsdk:"id"`
+	ModifierPercentage         types.Float64                                            `tfsdk:"modifier_percentage"`
+	Name                       types.String                                             `tfsdk:"name"`
+	Operation                  types.String                                             `tfsdk:"operation"`
+	Scope                      fwtypes.StringEnum[awstypes.PricingRuleScope]            `tfsdk:"scope"`
+	Service                    types.String                                             `tfsdk:"service"`
+	Tags                       tftags.Map                                               `tfsdk:"tags"`
+	TagsAll                    tftags.Map                                               `tfsdk:"tags_all"`
+	Tiering                    fwtypes.ListNestedObjectValueOf[pricingRuleTieringModel] `tfsdk:"tiering"`
+	Type                       fwtypes.StringEnum[awstypes.PricingRuleType]             `tfsdk:"type"`
+	UsageType                  types.String                                             `tfsdk:"usage_type"`
+}
+
+type pricingRuleTieringModel struct {
+	FreeTier fwtypes.ListNestedObjectValueOf[pricingRuleFreeTierModel] `tfsdk:"free_tier"`
+}
+
+type pricingRuleFreeTierModel struct {
+	Activated types.Bool `tfsdk:"activated"`
+}
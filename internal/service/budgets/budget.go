@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/budgets"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/budgets/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -60,9 +61,10 @@ func ResourceBudget() *schema.Resource {
 				Computed: true,
 			},
 			"auto_adjust_data": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"planned_limit"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"auto_adjust_type": {
@@ -295,8 +297,36 @@ func ResourceBudget() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.TimeUnit](),
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateAutoAdjustData,
+		),
+	}
+}
+
+// validateAutoAdjustData checks at plan time that historical options are configured only for historical auto-adjusting budgets.
+func validateAutoAdjustData(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("auto_adjust_data") {
+		return nil
 	}
+
+	v, ok := d.GetOk("auto_adjust_data")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	autoAdjustType := awstypes.AutoAdjustType(tfMap["auto_adjust_type"].(string))
+	historicalOptions, _ := tfMap["historical_options"].([]interface{})
+
+	switch hasHistoricalOptions := len(historicalOptions) > 0 && historicalOptions[0] != nil; {
+	case autoAdjustType == awstypes.AutoAdjustTypeHistorical && !hasHistoricalOptions:
+		return fmt.Errorf("auto_adjust_data.historical_options must be set when auto_adjust_type is %s", autoAdjustType)
+	case autoAdjustType == awstypes.AutoAdjustTypeForecast && hasHistoricalOptions:
+		return fmt.Errorf("auto_adjust_data.historical_options must not be set when auto_adjust_type is %s", autoAdjustType)
+	}
+
+	return nil
 }
 
 func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/budgets/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -276,6 +277,24 @@ func TestAccBudgetsBudget_autoAdjustDataHistorical(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_autoAdjustDataHistoricalMissingOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BudgetsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BudgetsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBudgetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetConfig_autoAdjustDataHistoricalMissingOptions(rName),
+				ExpectError: regexache.MustCompile(`historical_options must be set when auto_adjust_type is HISTORICAL`),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	ctx := acctest.Context(t)
 	var budget awstypes.Budget
@@ -651,6 +670,20 @@ resource "aws_budgets_budget" "test" {
 `, rName)
 }
 
+func testAccBudgetConfig_autoAdjustDataHistoricalMissingOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "HISTORICAL"
+  }
+}
+`, rName)
+}
+
 func testAccBudgetConfig_autoAdjustDataHistoricalUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_budgets_budget" "test" {
@@ -5,7 +5,11 @@ package ce
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"math"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -43,7 +47,11 @@ func resourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: customdiff.Sequence(verify.SetTagsDiff),
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateCostCategoryRules,
+			validateCostCategorySplitChargeRules,
+		),
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
@@ -297,6 +305,148 @@ func expressionElem(level int) *schema.Resource {
 	}
 }
 
+// validateCostCategoryRules checks at plan time that each rule is consistent with its type
+// and that each expression specifies exactly one operand.
+func validateCostCategoryRules(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value := tfMap[names.AttrValue].(string)
+		hasInheritedValue := len(tfMap["inherited_value"].([]interface{})) > 0
+		expressions := tfMap[names.AttrRule].([]interface{})
+
+		switch ruleType := awstypes.CostCategoryRuleType(tfMap[names.AttrType].(string)); ruleType {
+		case awstypes.CostCategoryRuleTypeInheritedValue:
+			if !hasInheritedValue {
+				return fmt.Errorf("rule with type %s: inherited_value must be set", ruleType)
+			}
+			if value != "" || len(expressions) > 0 {
+				return fmt.Errorf("rule with type %s: value and rule must not be set", ruleType)
+			}
+		default:
+			if hasInheritedValue {
+				return fmt.Errorf("rule (%s): inherited_value can only be set when type is %s", value, awstypes.CostCategoryRuleTypeInheritedValue)
+			}
+			if value == "" {
+				return errors.New("rule: value must be set")
+			}
+			if len(expressions) == 0 || expressions[0] == nil {
+				return fmt.Errorf("rule (%s): rule must be set", value)
+			}
+			if err := validateExpression(expressions[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("rule (%s): %w", value, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateExpression(tfMap map[string]interface{}) error {
+	var operands []string
+
+	for _, k := range []string{"and", "not", "or"} {
+		switch v := tfMap[k].(type) {
+		case *schema.Set:
+			if v.Len() > 0 {
+				operands = append(operands, k)
+
+				for _, tfMapRaw := range v.List() {
+					if err := validateExpression(tfMapRaw.(map[string]interface{})); err != nil {
+						return fmt.Errorf("%s: %w", k, err)
+					}
+				}
+			}
+		case []interface{}:
+			if len(v) > 0 && v[0] != nil {
+				operands = append(operands, k)
+
+				if err := validateExpression(v[0].(map[string]interface{})); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+			}
+		}
+	}
+
+	for _, k := range []string{"cost_category", "dimension", names.AttrTags} {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+			operands = append(operands, k)
+		}
+	}
+
+	if n := len(operands); n != 1 {
+		return fmt.Errorf("expression must specify exactly one of and, cost_category, dimension, not, or, tags; got %d", n)
+	}
+
+	return nil
+}
+
+// validateCostCategorySplitChargeRules checks at plan time that FIXED split charge rules allocate
+// a percentage to each target and that the percentages sum to 100.
+func validateCostCategorySplitChargeRules(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("split_charge_rule") {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("split_charge_rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		source := tfMap[names.AttrSource].(string)
+		parameters := tfMap[names.AttrParameter].(*schema.Set).List()
+
+		if method := awstypes.CostCategorySplitChargeMethod(tfMap["method"].(string)); method != awstypes.CostCategorySplitChargeMethodFixed {
+			if len(parameters) > 0 {
+				return fmt.Errorf("split_charge_rule (%s): parameter can only be set when method is %s", source, awstypes.CostCategorySplitChargeMethodFixed)
+			}
+
+			continue
+		}
+
+		if len(parameters) != 1 {
+			return fmt.Errorf("split_charge_rule (%s): exactly one parameter must be set when method is %s", source, awstypes.CostCategorySplitChargeMethodFixed)
+		}
+
+		parameter := parameters[0].(map[string]interface{})
+
+		if v := awstypes.CostCategorySplitChargeRuleParameterType(parameter[names.AttrType].(string)); v != awstypes.CostCategorySplitChargeRuleParameterTypeAllocationPercentages {
+			return fmt.Errorf("split_charge_rule (%s): parameter type must be %s", source, awstypes.CostCategorySplitChargeRuleParameterTypeAllocationPercentages)
+		}
+
+		values := flex.ExpandStringValueList(parameter[names.AttrValues].([]interface{}))
+
+		if got, want := len(values), tfMap["targets"].(*schema.Set).Len(); got != want {
+			return fmt.Errorf("split_charge_rule (%s): got %d allocation percentages, want one for each of the %d targets", source, got, want)
+		}
+
+		var total float64
+		for _, v := range values {
+			percentage, err := strconv.ParseFloat(v, 64)
+
+			if err != nil {
+				return fmt.Errorf("split_charge_rule (%s): allocation percentage (%s): %w", source, v, err)
+			}
+
+			total += percentage
+		}
+
+		if math.Abs(total-100) > 1e-9 {
+			return fmt.Errorf("split_charge_rule (%s): allocation percentages must sum to 100, got %g", source, total)
+		}
+	}
+
+	return nil
+}
+
 func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
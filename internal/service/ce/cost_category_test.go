@@ -218,6 +218,28 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCostCategoryPayerAccount(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCostCategoryConfig_multipleOperands(rName),
+				ExpectError: regexache.MustCompile(`expression must specify exactly one of`),
+			},
+			{
+				Config:      testAccCostCategoryConfig_splitChargeFixed(rName, "50", "40"),
+				ExpectError: regexache.MustCompile(`allocation percentages must sum to 100, got 90`),
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output awstypes.CostCategory
@@ -517,6 +539,96 @@ resource "aws_ce_cost_category" "test" {
 `, rName, method)
 }
 
+func testAccCostCategoryConfig_multipleOperands(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+
+      tags {
+        key    = "environment"
+        values = ["production"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+`, rName)
+}
+
+func testAccCostCategoryConfig_splitChargeFixed(rName, percentage1, percentage2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+
+  rule {
+    value = "shared"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-shared"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+
+  split_charge_rule {
+    method  = "FIXED"
+    source  = "shared"
+    targets = ["production", "staging"]
+
+    parameter {
+      type   = "ALLOCATION_PERCENTAGES"
+      values = [%[2]q, %[3]q]
+    }
+  }
+}
+`, rName, percentage1, percentage2)
+}
+
 func testAccCostCategoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ce_cost_category" "test" {
@@ -0,0 +1,614 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func newConfiguredTableAnalysisRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configuredTableAnalysisRuleResource{}, nil
+}
+
+type configuredTableAnalysisRuleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configuredTableAnalysisRuleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_table_analysis_rule"
+}
+
+func (r *configuredTableAnalysisRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	allowedJoinOperatorsAttribute := schema.SetAttribute{
+		CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.JoinOperator]](ctx),
+		ElementType: fwtypes.StringEnumType[awstypes.JoinOperator](),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+	columnNamesAttribute := func(optional bool) schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    optional,
+			Required:    !optional,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_rule_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfiguredTableAnalysisRuleType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_rule_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configuredTableAnalysisRulePolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"v1": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[configuredTableAnalysisRulePolicyV1Model](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"aggregation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleAggregationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"dimension_columns":      columnNamesAttribute(false),
+												"join_columns":           columnNamesAttribute(false),
+												"join_required": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.JoinRequiredOption](),
+													Optional:   true,
+												},
+												"scalar_functions": schema.SetAttribute{
+													CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.ScalarFunctions]](ctx),
+													ElementType: fwtypes.StringEnumType[awstypes.ScalarFunctions](),
+													Required:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"aggregate_column": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregateColumnModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_names": columnNamesAttribute(false),
+															"function": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregateFunctionName](),
+																Required:   true,
+															},
+														},
+													},
+												},
+												"output_constraint": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregationConstraintModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_name": schema.StringAttribute{
+																Required: true,
+															},
+															"minimum": schema.Int64Attribute{
+																Required: true,
+															},
+															names.AttrType: schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregationType](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"custom": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleCustomModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"allowed_analyses":           columnNamesAttribute(false),
+												"allowed_analysis_providers": columnNamesAttribute(true),
+											},
+											Blocks: map[string]schema.Block{
+												"differential_privacy": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"column": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyColumnModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtLeast(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrName: schema.StringAttribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"list": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleListModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"join_columns":           columnNamesAttribute(false),
+												"list_columns":           columnNamesAttribute(false),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *configuredTableAnalysisRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ConfiguredTableIdentifier = fwflex.StringFromFramework(ctx, data.ConfiguredTableID)
+
+	output, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Configured Table (%s) Analysis Rule (%s)", data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AnalysisRule, &data, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Clean Rooms Configured Table Analysis Rule", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Configured Table Analysis Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.AnalysisRulePolicy.Equal(old.AnalysisRulePolicy) {
+		input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.ConfiguredTableIdentifier = fwflex.StringFromFramework(ctx, new.ConfiguredTableID)
+
+		output, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Configured Table Analysis Rule (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.AnalysisRule, &new, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: fwflex.StringFromFramework(ctx, data.ConfiguredTableID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Configured Table Analysis Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType awstypes.ConfiguredTableAnalysisRuleType) (*awstypes.ConfiguredTableAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	output, err := conn.GetConfiguredTableAnalysisRule(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
+
+type configuredTableAnalysisRuleResourceModel struct {
+	AnalysisRulePolicy fwtypes.ListNestedObjectValueOf[configuredTableAnalysisRulePolicyModel] `tfsdk:"analysis_rule_policy"`
+	AnalysisRuleType   fwtypes.StringEnum[awstypes.ConfiguredTableAnalysisRuleType]            `tfsdk:"analysis_rule_type"`
+	ConfiguredTableID  types.String                                                            `tfsdk:"configured_table_id"`
+	ID                 types.String                                                            `tfsdk:"id"`
+}
+
+const (
+	configuredTableAnalysisRuleResourceIDPartCount = 2
+)
+
+func (m *configuredTableAnalysisRuleResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, configuredTableAnalysisRuleResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ConfiguredTableID = types.StringValue(parts[0])
+	m.AnalysisRuleType = fwtypes.StringEnumValue(awstypes.ConfiguredTableAnalysisRuleType(parts[1]))
+
+	return nil
+}
+
+func (m *configuredTableAnalysisRuleResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ConfiguredTableID.ValueString(),
+		m.AnalysisRuleType.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, configuredTableAnalysisRuleResourceIDPartCount, false)
+}
+
+type configuredTableAnalysisRulePolicyModel struct {
+	V1 fwtypes.ListNestedObjectValueOf[configuredTableAnalysisRulePolicyV1Model] `tfsdk:"v1"`
+}
+
+var (
+	_ fwflex.Expander  = configuredTableAnalysisRulePolicyModel{}
+	_ fwflex.Flattener = &configuredTableAnalysisRulePolicyModel{}
+)
+
+func (m configuredTableAnalysisRulePolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.V1.IsNull():
+		data, d := m.V1.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v, d := data.Expand(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: v.(awstypes.ConfiguredTableAnalysisRulePolicyV1),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *configuredTableAnalysisRulePolicyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ConfiguredTableAnalysisRulePolicyMemberV1:
+		var model configuredTableAnalysisRulePolicyV1Model
+		diags.Append(model.Flatten(ctx, t.Value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.V1 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type configuredTableAnalysisRulePolicyV1Model struct {
+	Aggregation fwtypes.ListNestedObjectValueOf[analysisRuleAggregationModel] `tfsdk:"aggregation"`
+	Custom      fwtypes.ListNestedObjectValueOf[analysisRuleCustomModel]      `tfsdk:"custom"`
+	List        fwtypes.ListNestedObjectValueOf[analysisRuleListModel]        `tfsdk:"list"`
+}
+
+var (
+	_ fwflex.Expander  = configuredTableAnalysisRulePolicyV1Model{}
+	_ fwflex.Flattener = &configuredTableAnalysisRulePolicyV1Model{}
+)
+
+func (m configuredTableAnalysisRulePolicyV1Model) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Aggregation.IsNull():
+		data, d := m.Aggregation.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Custom.IsNull():
+		data, d := m.Custom.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.List.IsNull():
+		data, d := m.List.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+// Flatten is called directly by configuredTableAnalysisRulePolicyModel with the (pointer) union member.
+func (m *configuredTableAnalysisRulePolicyV1Model) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		var model analysisRuleAggregationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Aggregation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+		var model analysisRuleCustomModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Custom = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		var model analysisRuleListModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.List = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type analysisRuleAggregationModel struct {
+	AggregateColumns     fwtypes.ListNestedObjectValueOf[aggregateColumnModel]            `tfsdk:"aggregate_column"`
+	AllowedJoinOperators fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.JoinOperator]]    `tfsdk:"allowed_join_operators"`
+	DimensionColumns     fwtypes.SetValueOf[types.String]                                 `tfsdk:"dimension_columns"`
+	JoinColumns          fwtypes.SetValueOf[types.String]                                 `tfsdk:"join_columns"`
+	JoinRequired         fwtypes.StringEnum[awstypes.JoinRequiredOption]                  `tfsdk:"join_required"`
+	OutputConstraints    fwtypes.ListNestedObjectValueOf[aggregationConstraintModel]      `tfsdk:"output_constraint"`
+	ScalarFunctions      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.ScalarFunctions]] `tfsdk:"scalar_functions"`
+}
+
+type aggregateColumnModel struct {
+	ColumnNames fwtypes.SetValueOf[types.String]                   `tfsdk:"column_names"`
+	Function    fwtypes.StringEnum[awstypes.AggregateFunctionName] `tfsdk:"function"`
+}
+
+type aggregationConstraintModel struct {
+	ColumnName types.String                                 `tfsdk:"column_name"`
+	Minimum    types.Int64                                  `tfsdk:"minimum"`
+	Type       fwtypes.StringEnum[awstypes.AggregationType] `tfsdk:"type"`
+}
+
+type analysisRuleCustomModel struct {
+	AllowedAnalyses          fwtypes.SetValueOf[types.String]                                       `tfsdk:"allowed_analyses"`
+	AllowedAnalysisProviders fwtypes.SetValueOf[types.String]                                       `tfsdk:"allowed_analysis_providers"`
+	DifferentialPrivacy      fwtypes.ListNestedObjectValueOf[differentialPrivacyConfigurationModel] `tfsdk:"differential_privacy"`
+}
+
+type differentialPrivacyConfigurationModel struct {
+	Columns fwtypes.ListNestedObjectValueOf[differentialPrivacyColumnModel] `tfsdk:"column"`
+}
+
+type differentialPrivacyColumnModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type analysisRuleListModel struct {
+	AllowedJoinOperators fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.JoinOperator]] `tfsdk:"allowed_join_operators"`
+	JoinColumns          fwtypes.SetValueOf[types.String]                              `tfsdk:"join_columns"`
+	ListColumns          fwtypes.SetValueOf[types.String]                              `tfsdk:"list_columns"`
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_association", name="Configured Table Association")
+// @Tags(identifierAttribute="arn")
+func newConfiguredTableAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configuredTableAssociationResource{}, nil
+}
+
+type configuredTableAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configuredTableAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_table_association"
+}
+
+func (r *configuredTableAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *configuredTableAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ConfiguredTableIdentifier = fwflex.StringFromFramework(ctx, data.ConfiguredTableID)
+	input.MembershipIdentifier = fwflex.StringFromFramework(ctx, data.MembershipID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfiguredTableAssociation(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Configured Table Association (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	association := output.ConfiguredTableAssociation
+	data.ARN = fwflex.StringToFramework(ctx, association.Arn)
+	data.AssociationID = fwflex.StringToFramework(ctx, association.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Clean Rooms Configured Table Association", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configuredTableAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findConfiguredTableAssociationByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.AssociationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Configured Table Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	// Don't use fwflex.Flatten as the association's Id would overwrite the composite resource ID.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ConfiguredTableID = fwflex.StringToFramework(ctx, output.ConfiguredTableId)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.RoleARN = fwtypes.ARNValue(aws.ToString(output.RoleArn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configuredTableAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.RoleARN.Equal(old.RoleARN) {
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: fwflex.StringFromFramework(ctx, new.AssociationID),
+			Description:                          fwflex.StringFromFramework(ctx, new.Description),
+			MembershipIdentifier:                 fwflex.StringFromFramework(ctx, new.MembershipID),
+			RoleArn:                              fwflex.StringFromFramework(ctx, new.RoleARN),
+		}
+
+		_, err := conn.UpdateConfiguredTableAssociation(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Configured Table Association (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configuredTableAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteConfiguredTableAssociation(ctx, &cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: fwflex.StringFromFramework(ctx, data.AssociationID),
+		MembershipIdentifier:                 fwflex.StringFromFramework(ctx, data.MembershipID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Configured Table Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configuredTableAssociationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, associationID string) (*awstypes.ConfiguredTableAssociation, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociation(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTableAssociation, nil
+}
+
+type configuredTableAssociationResourceModel struct {
+	ARN               types.String `tfsdk:"arn"`
+	AssociationID     types.String `tfsdk:"association_id"`
+	ConfiguredTableID types.String `tfsdk:"configured_table_id"`
+	Description       types.String `tfsdk:"description"`
+	ID                types.String `tfsdk:"id"`
+	MembershipID      types.String `tfsdk:"membership_id"`
+	Name              types.String `tfsdk:"name"`
+	RoleARN           fwtypes.ARN  `tfsdk:"role_arn"`
+	Tags              tftags.Map   `tfsdk:"tags"`
+	TagsAll           tftags.Map   `tfsdk:"tags_all"`
+}
+
+const (
+	configuredTableAssociationResourceIDPartCount = 2
+)
+
+func (m *configuredTableAssociationResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, configuredTableAssociationResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.MembershipID = types.StringValue(parts[0])
+	m.AssociationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *configuredTableAssociationResourceModel) setID() (string, error) {
+	parts := []string{
+		m.MembershipID.ValueString(),
+		m.AssociationID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, configuredTableAssociationResourceIDPartCount, false)
+}
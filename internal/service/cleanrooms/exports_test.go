@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	ResourceConfiguredTableAnalysisRule = newConfiguredTableAnalysisRuleResource
+	ResourceConfiguredTableAssociation  = newConfiguredTableAssociationResource
+	ResourceMembership                  = newMembershipResource
+	ResourcePrivacyBudgetTemplate       = newPrivacyBudgetTemplateResource
+
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindMembershipByID                          = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey       = findPrivacyBudgetTemplateByTwoPartKey
+)
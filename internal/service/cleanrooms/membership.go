@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cleanrooms_membership", name="Membership")
+// @Tags(identifierAttribute="arn")
+func newMembershipResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &membershipResource{}, nil
+}
+
+type membershipResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*membershipResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_membership"
+}
+
+func (r *membershipResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_creator_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_creator_display_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaboration_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_abilities": schema.SetAttribute{
+				CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.MemberAbility]](ctx),
+				ElementType: fwtypes.StringEnumType[awstypes.MemberAbility](),
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_log_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MembershipQueryLogStatus](),
+				Required:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MembershipStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"default_result_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[membershipProtectedQueryResultConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"output_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[membershipProtectedQueryOutputConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[protectedQueryS3OutputConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												"key_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+												"result_format": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ResultFormat](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"payment_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[membershipPaymentConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"query_compute": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[membershipQueryComputePaymentConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"is_responsible": schema.BoolAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *membershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	input := &cleanrooms.CreateMembershipInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.CollaborationIdentifier = fwflex.StringFromFramework(ctx, data.CollaborationID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMembership(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Membership (%s)", data.CollaborationID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Membership, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *membershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findMembershipByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Membership (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *membershipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new membershipResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.DefaultResultConfiguration.Equal(old.DefaultResultConfiguration) || !new.QueryLogStatus.Equal(old.QueryLogStatus) {
+		input := &cleanrooms.UpdateMembershipInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.MembershipIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateMembership(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Membership (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Membership, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *membershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Membership (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *membershipResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*awstypes.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembership(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Membership.Status; status == awstypes.MembershipStatusRemoved {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Membership, nil
+}
+
+type membershipResourceModel struct {
+	ARN                             types.String                                                                      `tfsdk:"arn"`
+	CollaborationARN                types.String                                                                      `tfsdk:"collaboration_arn"`
+	CollaborationCreatorAccountID   types.String                                                                      `tfsdk:"collaboration_creator_account_id"`
+	CollaborationCreatorDisplayName types.String                                                                      `tfsdk:"collaboration_creator_display_name"`
+	CollaborationID                 types.String                                                                      `tfsdk:"collaboration_id"`
+	CollaborationName               types.String                                                                      `tfsdk:"collaboration_name"`
+	DefaultResultConfiguration      fwtypes.ListNestedObjectValueOf[membershipProtectedQueryResultConfigurationModel] `tfsdk:"default_result_configuration"`
+	ID                              types.String                                                                      `tfsdk:"id"`
+	MemberAbilities                 fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.MemberAbility]]                    `tfsdk:"member_abilities"`
+	PaymentConfiguration            fwtypes.ListNestedObjectValueOf[membershipPaymentConfigurationModel]              `tfsdk:"payment_configuration"`
+	QueryLogStatus                  fwtypes.StringEnum[awstypes.MembershipQueryLogStatus]                             `tfsdk:"query_log_status"`
+	Status                          fwtypes.StringEnum[awstypes.MembershipStatus]                                     `tfsdk:"status"`
+	Tags                            tftags.Map                                                                        `tfsdk:"tags"`
+	TagsAll                         tftags.Map                                                                        `tfsdk:"tags_all"`
+}
+
+type membershipProtectedQueryResultConfigurationModel struct {
+	OutputConfiguration fwtypes.ListNestedObjectValueOf[membershipProtectedQueryOutputConfigurationModel] `tfsdk:"output_configuration"`
+	RoleARN             fwtypes.ARN                                                                       `tfsdk:"role_arn"`
+}
+
+type membershipProtectedQueryOutputConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[protectedQueryS3OutputConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = membershipProtectedQueryOutputConfigurationModel{}
+	_ fwflex.Flattener = &membershipProtectedQueryOutputConfigurationModel{}
+)
+
+func (m membershipProtectedQueryOutputConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3.IsNull():
+		data, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.MembershipProtectedQueryOutputConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *membershipProtectedQueryOutputConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.MembershipProtectedQueryOutputConfigurationMemberS3:
+		var model protectedQueryS3OutputConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type protectedQueryS3OutputConfigurationModel struct {
+	Bucket       types.String                              `tfsdk:"bucket"`
+	KeyPrefix    types.String                              `tfsdk:"key_prefix"`
+	ResultFormat fwtypes.StringEnum[awstypes.ResultFormat] `tfsdk:"result_format"`
+}
+
+type membershipPaymentConfigurationModel struct {
+	QueryCompute fwtypes.ListNestedObjectValueOf[membershipQueryComputePaymentConfigModel] `tfsdk:"query_compute"`
+}
+
+type membershipQueryComputePaymentConfigModel struct {
+	IsResponsible types.Bool `tfsdk:"is_responsible"`
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cleanrooms_privacy_budget_template", name="Privacy Budget Template")
+// @Tags(identifierAttribute="arn")
+func newPrivacyBudgetTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &privacyBudgetTemplateResource{}, nil
+}
+
+type privacyBudgetTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*privacyBudgetTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_privacy_budget_template"
+}
+
+func (r *privacyBudgetTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auto_refresh": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetTemplateAutoRefresh](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privacy_budget_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"privacy_budget_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyTemplateParametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"epsilon": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 20),
+							},
+						},
+						"users_noise_per_query": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(10, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *privacyBudgetTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	parameters, diags := data.Parameters.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cleanrooms.CreatePrivacyBudgetTemplateInput{
+		AutoRefresh:          data.AutoRefresh.ValueEnum(),
+		MembershipIdentifier: fwflex.StringFromFramework(ctx, data.MembershipID),
+		Parameters: &awstypes.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy{
+			Value: awstypes.DifferentialPrivacyTemplateParametersInput{
+				Epsilon:            fwflex.Int32FromFramework(ctx, parameters.Epsilon),
+				UsersNoisePerQuery: fwflex.Int32FromFramework(ctx, parameters.UsersNoisePerQuery),
+			},
+		},
+		PrivacyBudgetType: data.PrivacyBudgetType.ValueEnum(),
+		Tags:              getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePrivacyBudgetTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Privacy Budget Template (%s)", data.MembershipID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	template := output.PrivacyBudgetTemplate
+	data.ARN = fwflex.StringToFramework(ctx, template.Arn)
+	data.PrivacyBudgetTemplateID = fwflex.StringToFramework(ctx, template.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Clean Rooms Privacy Budget Template", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *privacyBudgetTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.PrivacyBudgetTemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Privacy Budget Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	// Don't use fwflex.Flatten as the template's Id would overwrite the composite resource ID.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.AutoRefresh = fwtypes.StringEnumValue(output.AutoRefresh)
+	data.PrivacyBudgetType = fwtypes.StringEnumValue(output.PrivacyBudgetType)
+	response.Diagnostics.Append(data.flattenParameters(ctx, output.Parameters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *privacyBudgetTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Parameters.Equal(old.Parameters) {
+		parameters, diags := new.Parameters.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier: fwflex.StringFromFramework(ctx, new.MembershipID),
+			Parameters: &awstypes.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy{
+				Value: awstypes.DifferentialPrivacyTemplateUpdateParameters{
+					Epsilon:            fwflex.Int32FromFramework(ctx, parameters.Epsilon),
+					UsersNoisePerQuery: fwflex.Int32FromFramework(ctx, parameters.UsersNoisePerQuery),
+				},
+			},
+			PrivacyBudgetTemplateIdentifier: fwflex.StringFromFramework(ctx, new.PrivacyBudgetTemplateID),
+			PrivacyBudgetType:               new.PrivacyBudgetType.ValueEnum(),
+		}
+
+		_, err := conn.UpdatePrivacyBudgetTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Privacy Budget Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *privacyBudgetTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeletePrivacyBudgetTemplate(ctx, &cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            fwflex.StringFromFramework(ctx, data.MembershipID),
+		PrivacyBudgetTemplateIdentifier: fwflex.StringFromFramework(ctx, data.PrivacyBudgetTemplateID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Privacy Budget Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *privacyBudgetTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, templateID string) (*awstypes.PrivacyBudgetTemplate, error) {
+	input := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(templateID),
+	}
+
+	output, err := conn.GetPrivacyBudgetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PrivacyBudgetTemplate, nil
+}
+
+type privacyBudgetTemplateResourceModel struct {
+	ARN                     types.String                                                                `tfsdk:"arn"`
+	AutoRefresh             fwtypes.StringEnum[awstypes.PrivacyBudgetTemplateAutoRefresh]               `tfsdk:"auto_refresh"`
+	ID                      types.String                                                                `tfsdk:"id"`
+	MembershipID            types.String                                                                `tfsdk:"membership_id"`
+	Parameters              fwtypes.ListNestedObjectValueOf[differentialPrivacyTemplateParametersModel] `tfsdk:"parameters"`
+	PrivacyBudgetTemplateID types.String                                                                `tfsdk:"privacy_budget_template_id"`
+	PrivacyBudgetType       fwtypes.StringEnum[awstypes.PrivacyBudgetType]                              `tfsdk:"privacy_budget_type"`
+	Tags                    tftags.Map                                                                  `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                                  `tfsdk:"tags_all"`
+}
+
+const (
+	privacyBudgetTemplateResourceIDPartCount = 2
+)
+
+func (m *privacyBudgetTemplateResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.MembershipID = types.StringValue(parts[0])
+	m.PrivacyBudgetTemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *privacyBudgetTemplateResourceModel) setID() (string, error) {
+	parts := []string{
+		m.MembershipID.ValueString(),
+		m.PrivacyBudgetTemplateID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, privacyBudgetTemplateResourceIDPartCount, false)
+}
+
+// flattenParameters flattens the output parameters union, which differs from the create and update input unions.
+func (m *privacyBudgetTemplateResourceModel) flattenParameters(ctx context.Context, apiObject awstypes.PrivacyBudgetTemplateParametersOutput) (diags diag.Diagnostics) {
+	switch t := apiObject.(type) {
+	case *awstypes.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy:
+		m.Parameters = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &differentialPrivacyTemplateParametersModel{
+			Epsilon:            fwflex.Int32ToFramework(ctx, t.Value.Epsilon),
+			UsersNoisePerQuery: fwflex.Int32ToFramework(ctx, t.Value.UsersNoisePerQuery),
+		})
+	}
+
+	return diags
+}
+
+type differentialPrivacyTemplateParametersModel struct {
+	Epsilon            types.Int64 `tfsdk:"epsilon"`
+	UsersNoisePerQuery types.Int64 `tfsdk:"users_noise_per_query"`
+}
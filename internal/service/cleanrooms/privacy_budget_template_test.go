@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "20"),
+					resource.TestCheckResourceAttrSet(resourceName, "privacy_budget_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 2, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourcePrivacyBudgetTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Privacy Budget Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		_, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+		return err
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(rName string, epsilon, usersNoisePerQuery int) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), fmt.Sprintf(`
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_id       = aws_cleanrooms_membership.test.id
+  auto_refresh        = "CALENDAR_MONTH"
+  privacy_budget_type = "DIFFERENTIAL_PRIVACY"
+
+  parameters {
+    epsilon               = %[1]d
+    users_noise_per_query = %[2]d
+  }
+}
+`, epsilon, usersNoisePerQuery))
+}
@@ -4,8 +4,14 @@
 package cloudformation
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -43,6 +49,86 @@ func findChangeSetByTwoPartKey(ctx context.Context, conn *cloudformation.Client,
 	return output, nil
 }
 
+// findChangeSetChangesByTwoPartKey returns all of the change set's changes.
+func findChangeSetChangesByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) ([]awstypes.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []awstypes.Change
+
+	for {
+		page, err := conn.DescribeChangeSet(ctx, input)
+
+		if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Changes...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func deleteChangeSet(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) error {
+	_, err := conn.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	})
+
+	if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+// changeSetName returns a name for the change set that is unique to its inputs.
+func changeSetName(input *cloudformation.CreateChangeSetInput) (string, error) {
+	// Parameters and tags are expanded from maps, so their order isn't stable.
+	v := *input
+	v.Parameters = slices.SortedFunc(slices.Values(input.Parameters), func(a, b awstypes.Parameter) int {
+		return cmp.Compare(aws.ToString(a.ParameterKey), aws.ToString(b.ParameterKey))
+	})
+	v.Tags = slices.SortedFunc(slices.Values(input.Tags), func(a, b awstypes.Tag) int {
+		return cmp.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+	})
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+
+	return "terraform-" + hex.EncodeToString(hash[:16]), nil
+}
+
+// isNoChangesChangeSet returns whether the change set failed because it contains no changes.
+func isNoChangesChangeSet(output *cloudformation.DescribeChangeSetOutput) bool {
+	if output.Status != awstypes.ChangeSetStatusFailed {
+		return false
+	}
+
+	reason := aws.ToString(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
 func statusChangeSet(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findChangeSetByTwoPartKey(ctx, conn, stackID, changeSetName)
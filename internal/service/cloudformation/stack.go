@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -61,6 +62,38 @@ func resourceStack() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Capability](),
 				},
 			},
+			"change_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_set_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -132,11 +165,16 @@ func resourceStack() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"use_change_sets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			stackChangeSetCustomizeDiff,
 		),
 	}
 }
@@ -279,6 +317,14 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	if d.Get("use_change_sets").(bool) {
+		if err := updateStackWithChangeSet(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+		}
+
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
@@ -694,3 +740,214 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// stackChangeSetCustomizeDiff plans an update of an existing stack that uses change sets by creating the change set,
+// so that the changes to the stack's resources can be previewed before apply.
+func stackChangeSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get("use_change_sets").(bool) || !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	// The change set can only be created once all of its inputs are known.
+	for _, k := range []string{"capabilities", names.AttrIAMRoleARN, "notification_arns", names.AttrParameters, names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(k) {
+			if err := d.SetNewComputed("change_set_id"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("change_set_summary")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	changeSetID, changes, err := planStackChangeSet(ctx, conn, d)
+
+	if err != nil {
+		return fmt.Errorf("planning CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	if err := d.SetNew("change_set_id", changeSetID); err != nil {
+		return err
+	}
+
+	return d.SetNew("change_set_summary", flattenChanges(changes))
+}
+
+// planStackChangeSet creates a change set for the stack's update, or finds the one already created for the same update,
+// and returns its ID and changes. An empty ID is returned if the update doesn't change any of the stack's resources.
+func planStackChangeSet(ctx context.Context, conn *cloudformation.Client, d sdkv2.ResourceDiffer) (string, []awstypes.Change, error) {
+	input, err := expandStackChangeSetInput(ctx, d)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The change set's name is derived from its inputs so that the plan made during apply finds the change set created during plan.
+	name, err := changeSetName(input)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	input.ChangeSetName = aws.String(name)
+	stackID := d.Id()
+
+	output, err := findChangeSetByTwoPartKey(ctx, conn, stackID, name)
+	create := false
+
+	switch {
+	case tfresource.NotFound(err):
+		create = true
+	case err != nil:
+		return "", nil, fmt.Errorf("reading change set (%s): %w", name, err)
+	case output.ExecutionStatus == awstypes.ExecutionStatusAvailable, output.Status == awstypes.ChangeSetStatusCreatePending, output.Status == awstypes.ChangeSetStatusCreateInProgress:
+	default:
+		// The change set has already been executed, has failed or is out of date.
+		if err := deleteChangeSet(ctx, conn, stackID, name); err != nil {
+			return "", nil, fmt.Errorf("deleting change set (%s): %w", name, err)
+		}
+
+		create = true
+	}
+
+	if create {
+		_, err := tfresource.RetryWhenIsA[*awstypes.AlreadyExistsException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.CreateChangeSet(ctx, input)
+		})
+
+		if err != nil {
+			return "", nil, fmt.Errorf("creating change set (%s): %w", name, err)
+		}
+	}
+
+	output, err = waitChangeSetCreated(ctx, conn, stackID, name)
+
+	if output != nil && isNoChangesChangeSet(output) {
+		return "", nil, nil
+	}
+
+	if err != nil {
+		return "", nil, fmt.Errorf("waiting for change set (%s) create: %w", name, err)
+	}
+
+	changes, err := findChangeSetChangesByTwoPartKey(ctx, conn, stackID, name)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("reading change set (%s) changes: %w", name, err)
+	}
+
+	return aws.ToString(output.ChangeSetId), changes, nil
+}
+
+// updateStackWithChangeSet executes the stack's planned change set, planning it first if it couldn't be planned during plan.
+func updateStackWithChangeSet(ctx context.Context, conn *cloudformation.Client, d *schema.ResourceData) error {
+	changeSetID := d.Get("change_set_id").(string)
+
+	if changeSetID == "" {
+		var err error
+		changeSetID, _, err = planStackChangeSet(ctx, conn, d)
+
+		if err != nil {
+			return fmt.Errorf("planning change set: %w", err)
+		}
+	}
+
+	if changeSetID != "" {
+		requestToken := id.UniqueId()
+		input := &cloudformation.ExecuteChangeSetInput{
+			ChangeSetName:      aws.String(changeSetID),
+			ClientRequestToken: aws.String(requestToken),
+			StackName:          aws.String(d.Id()),
+		}
+
+		if _, err := conn.ExecuteChangeSet(ctx, input); err != nil {
+			return fmt.Errorf("executing change set (%s): %w", changeSetID, err)
+		}
+
+		if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for change set (%s) execution: %w", changeSetID, err)
+		}
+	}
+
+	// Change sets don't include the stack policy.
+	if d.HasChanges("policy_body", "policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_body"); ok && d.HasChange("policy_body") {
+			policy, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return err
+			}
+			input.StackPolicyBody = aws.String(policy)
+		} else if v, ok := d.GetOk("policy_url"); ok {
+			input.StackPolicyURL = aws.String(v.(string))
+		}
+
+		if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+			if _, err := conn.SetStackPolicy(ctx, input); err != nil {
+				return fmt.Errorf("setting stack policy: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandStackChangeSetInput(ctx context.Context, d sdkv2.ResourceDiffer) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		Description:   aws.String("Managed by Terraform"),
+		StackName:     aws.String(d.Id()),
+		Tags:          Tags(tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{})).IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	return input, nil
+}
+
+func flattenChanges(apiObjects []awstypes.Change) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.ResourceChange
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:       string(v.Action),
+			"logical_resource_id":  aws.ToString(v.LogicalResourceId),
+			"physical_resource_id": aws.ToString(v.PhysicalResourceId),
+			"replacement":          string(v.Replacement),
+			names.AttrResourceType: aws.ToString(v.ResourceType),
+		})
+	}
+
+	return tfList
+}
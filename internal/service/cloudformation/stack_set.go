@@ -102,6 +102,51 @@ func resourceStackSet() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"drift_detection_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"drift_detection_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"drifted_stack_instances_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"failed_stack_instances_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"in_progress_stack_instances_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"in_sync_stack_instances_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_drift_check_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_stack_instances_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"drift_detection_triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"execution_role_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -139,6 +184,11 @@ func resourceStackSet() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"concurrency_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ConcurrencyMode](),
+						},
 						"failure_tolerance_count": {
 							Type:          schema.TypeInt,
 							Optional:      true,
@@ -366,6 +416,9 @@ func resourceStackSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("capabilities", stackSet.Capabilities)
 	d.Set(names.AttrDescription, stackSet.Description)
+	if err := d.Set("drift_detection_details", flattenStackSetDriftDetectionDetails(stackSet.StackSetDriftDetectionDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting drift_detection_details: %s", err)
+	}
 	d.Set("execution_role_name", stackSet.ExecutionRoleName)
 	if err := d.Set("managed_execution", flattenStackSetManagedExecution(stackSet.ManagedExecution)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting managed_execution: %s", err)
@@ -387,6 +440,43 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	callAs := d.Get("call_as").(string)
+
+	if d.HasChangesExcept("drift_detection_triggers") {
+		if err := updateStackSet(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation StackSet (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("drift_detection_triggers") {
+		input := &cloudformation.DetectStackSetDriftInput{
+			OperationId:  aws.String(id.UniqueId()),
+			StackSetName: aws.String(d.Id()),
+		}
+
+		if callAs != "" {
+			input.CallAs = awstypes.CallAs(callAs)
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		output, err := conn.DetectStackSetDrift(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet (%s) drift: %s", d.Id(), err)
+		}
+
+		if _, err := waitStackSetOperationSucceeded(ctx, conn, d.Id(), aws.ToString(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) drift detection: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStackSetRead(ctx, d, meta)...)
+}
+
+func updateStackSet(ctx context.Context, conn *cloudformation.Client, d *schema.ResourceData) error {
 	input := &cloudformation.UpdateStackSetInput{
 		OperationId:  aws.String(id.UniqueId()),
 		StackSetName: aws.String(d.Id()),
@@ -399,8 +489,8 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	callAs := d.Get("call_as").(string)
-	if v, ok := d.GetOk("call_as"); ok {
-		input.CallAs = awstypes.CallAs(v.(string))
+	if callAs != "" {
+		input.CallAs = awstypes.CallAs(callAs)
 	}
 
 	if v, ok := d.GetOk("capabilities"); ok {
@@ -454,14 +544,14 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	output, err := conn.UpdateStackSet(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating CloudFormation StackSet (%s): %s", d.Id(), err)
+		return err
 	}
 
 	if _, err := waitStackSetOperationSucceeded(ctx, conn, d.Id(), aws.ToString(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
+		return fmt.Errorf("waiting for update: %w", err)
 	}
 
-	return append(diags, resourceStackSetRead(ctx, d, meta)...)
+	return nil
 }
 
 func resourceStackSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -654,7 +744,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		stackSetOperationDelay = 10 * time.Second
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.StackSetOperationStatusRunning, awstypes.StackSetOperationStatusQueued),
+		Pending: enum.Slice(awstypes.StackSetOperationStatusRunning, awstypes.StackSetOperationStatusQueued, awstypes.StackSetOperationStatusStopping),
 		Target:  enum.Slice(awstypes.StackSetOperationStatusSucceeded),
 		Refresh: statusStackSetOperation(ctx, conn, stackSetName, operationID, callAs),
 		Timeout: timeout,
@@ -676,15 +766,25 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 	return nil, err
 }
 
+// stackSetOperationError returns an error for each account and Region in which the operation didn't succeed.
 func stackSetOperationError(apiObjects []awstypes.StackSetOperationResultSummary) error {
 	var errs []error
 
 	for _, apiObject := range apiObjects {
+		if apiObject.Status == awstypes.StackSetOperationResultStatusSucceeded {
+			continue
+		}
+
+		reason := aws.ToString(apiObject.StatusReason)
+		if v := apiObject.AccountGateResult; v != nil && v.Status == awstypes.AccountGateStatusFailed {
+			reason = fmt.Sprintf("%s (account gate: %s)", reason, aws.ToString(v.StatusReason))
+		}
+
 		errs = append(errs, fmt.Errorf("Account (%s), Region (%s), %s: %s",
 			aws.ToString(apiObject.Account),
 			aws.ToString(apiObject.Region),
 			string(apiObject.Status),
-			aws.ToString(apiObject.StatusReason),
+			reason,
 		))
 	}
 
@@ -748,3 +848,25 @@ func flattenStackSetManagedExecution(managedExecution *awstypes.ManagedExecution
 
 	return []map[string]interface{}{m}
 }
+
+func flattenStackSetDriftDetectionDetails(apiObject *awstypes.StackSetDriftDetectionDetails) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"drift_detection_status":            string(apiObject.DriftDetectionStatus),
+		"drift_status":                      string(apiObject.DriftStatus),
+		"drifted_stack_instances_count":     aws.ToInt32(apiObject.DriftedStackInstancesCount),
+		"failed_stack_instances_count":      aws.ToInt32(apiObject.FailedStackInstancesCount),
+		"in_progress_stack_instances_count": aws.ToInt32(apiObject.InProgressStackInstancesCount),
+		"in_sync_stack_instances_count":     aws.ToInt32(apiObject.InSyncStackInstancesCount),
+		"total_stack_instances_count":       aws.ToInt32(apiObject.TotalStackInstancesCount),
+	}
+
+	if v := apiObject.LastDriftCheckTimestamp; v != nil {
+		tfMap["last_drift_check_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -416,6 +416,40 @@ func TestAccCloudFormationStackSet_operationPreferences(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSet_driftDetection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackSet awstypes.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetConfig_driftDetection(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.concurrency_mode", string(awstypes.ConcurrencyModeSoftFailureTolerance)),
+				),
+			},
+			{
+				Config: testAccStackSetConfig_driftDetection(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_details.0.drift_detection_status", string(awstypes.StackSetDriftDetectionStatusCompleted)),
+					resource.TestCheckResourceAttrSet(resourceName, "drift_detection_details.0.last_drift_check_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSet_parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackSet1, stackSet2 awstypes.StackSet
@@ -1466,6 +1500,29 @@ TEMPLATE
 `, rName, failureTolerancePercentage, maxConcurrentPercentage, testAccStackSetTemplateBodyVPC(rName)))
 }
 
+func testAccStackSetConfig_driftDetection(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccStackSetConfig_baseAdministrationRoleARNs(rName, 1), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = aws_iam_role.test[0].arn
+  name                    = %[1]q
+
+  drift_detection_triggers = {
+    run = %[2]q
+  }
+
+  operation_preferences {
+    concurrency_mode        = "SOFT_FAILURE_TOLERANCE"
+    failure_tolerance_count = 1
+    max_concurrent_count    = 2
+  }
+
+  template_body = <<TEMPLATE
+%[3]s
+TEMPLATE
+}
+`, rName, trigger, testAccStackSetTemplateBodyVPC(rName)))
+}
+
 func testAccStackSetConfig_autoDeployment(rName string, enabled, retainStacksOnAccountRemoval bool) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack_set" "test" {
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -326,6 +327,50 @@ func TestAccCloudFormationStack_withParams(t *testing.T) {
 }
 
 // Regression for https://github.com/hashicorp/terraform/issues/4534
+func TestAccCloudFormationStack_changeSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_changeSet(rName, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "use_change_sets", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccStackConfig_changeSet(rName, "10.1.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("change_set_summary").AtSliceIndex(0).AtMapKey(names.AttrAction), knownvalue.StringExact("Modify")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("change_set_summary").AtSliceIndex(0).AtMapKey("logical_resource_id"), knownvalue.StringExact("MyVPC")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("change_set_summary").AtSliceIndex(0).AtMapKey("replacement"), knownvalue.StringExact("True")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("change_set_summary").AtSliceIndex(0).AtMapKey(names.AttrResourceType), knownvalue.StringExact("AWS::EC2::VPC")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttrSet(resourceName, "change_set_id"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStack_WithURL_withParams(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stack awstypes.Stack
@@ -924,6 +969,37 @@ STACK
 `, rName, cidr)
 }
 
+func testAccStackConfig_changeSet(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+
+  use_change_sets = true
+}
+`, rName, cidr)
+}
+
 func testAccStackConfig_baseTemplateURL(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
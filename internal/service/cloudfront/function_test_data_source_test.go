@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontFunctionTestDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudfront_function_test.test"
+	resourceName := "aws_cloudfront_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionTestDataSourceConfig_basic(rName, "return response;"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_utilization"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_error_message", ""),
+					resource.TestMatchResourceAttr(dataSourceName, "function_output", regexache.MustCompile(`"statusCode":302`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStage, "DEVELOPMENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontFunctionTestDataSource_error(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionTestDataSourceConfig_basic(rName, "return undefinedVariable.value;"),
+				ExpectError: regexache.MustCompile(`test failed`),
+			},
+		},
+	})
+}
+
+func testAccFunctionTestDataSourceConfig_basic(rName, returnStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+function handler(event) {
+	var response = {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'location': { value: 'https://aws.amazon.com/cloudfront/' }
+		}
+	};
+	%[2]s
+}
+EOT
+}
+
+data "aws_cloudfront_function_test" "test" {
+  name = aws_cloudfront_function.test.name
+
+  event_object = jsonencode({
+    version = "1.0"
+    context = {
+      eventType = "viewer-request"
+    }
+    viewer = {
+      ip = "198.51.100.11"
+    }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = {}
+      cookies     = {}
+      querystring = {}
+    }
+  })
+}
+`, rName, returnStatement)
+}
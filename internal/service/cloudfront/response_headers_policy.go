@@ -5,7 +5,9 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -23,6 +25,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// The maximum length of a header value, including the Content-Security-Policy header value.
+	responseHeadersPolicyHeaderValueMaxLength = 1783
+)
+
 // @SDKResource("aws_cloudfront_response_headers_policy", name="Response Headers Policy")
 func resourceResponseHeadersPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -130,16 +137,18 @@ func resourceResponseHeadersPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrHeader: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validResponseHeadersPolicyCustomHeaderName,
 									},
 									"override": {
 										Type:     schema.TypeBool,
 										Required: true,
 									},
 									names.AttrValue: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, responseHeadersPolicyHeaderValueMaxLength),
 									},
 								},
 							},
@@ -192,8 +201,9 @@ func resourceResponseHeadersPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"content_security_policy": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, responseHeadersPolicyHeaderValueMaxLength),
 									},
 									"override": {
 										Type:     schema.TypeBool,
@@ -1307,3 +1317,34 @@ func flattenResponseHeadersPolicyServerTimingHeadersConfig(apiObject *awstypes.R
 
 	return tfMap
 }
+
+// responseHeadersPolicySecurityHeaderNames are the headers that can only be configured using `security_headers_config`.
+var responseHeadersPolicySecurityHeaderNames = []string{
+	"Content-Security-Policy",
+	"Referrer-Policy",
+	"Strict-Transport-Security",
+	"X-Content-Type-Options",
+	"X-Frame-Options",
+	"X-XSS-Protection",
+}
+
+// validResponseHeadersPolicyCustomHeaderName validates a custom header name.
+// Security headers must be configured using `security_headers_config`, but similarly named headers
+// such as Content-Security-Policy-Report-Only are valid custom headers.
+func validResponseHeadersPolicyCustomHeaderName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	for _, name := range responseHeadersPolicySecurityHeaderNames {
+		if strings.EqualFold(value, name) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be configured using security_headers_config", k, value))
+			return
+		}
+	}
+
+	return
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -145,6 +146,46 @@ func TestAccCloudFrontResponseHeadersPolicy_customHeaders(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontResponseHeadersPolicy_customHeadersContentSecurityPolicyReportOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_response_headers_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponseHeadersPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResponseHeadersPolicyConfig_customHeader(rName, "Content-Security-Policy"),
+				ExpectError: regexache.MustCompile(`must be configured using security_headers_config`),
+			},
+			{
+				Config: testAccResponseHeadersPolicyConfig_customHeader(rName, "Content-Security-Policy-Report-Only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponseHeadersPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_headers_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_headers_config.0.items.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_headers_config.0.items.*", map[string]string{
+						names.AttrHeader: "Content-Security-Policy-Report-Only",
+						"override":       acctest.CtTrue,
+						names.AttrValue:  "default-src 'self'; report-uri https://example.com/csp-report",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "security_headers_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_headers_config.0.content_security_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_headers_config.0.content_security_policy.0.content_security_policy", "default-src 'self'"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontResponseHeadersPolicy_RemoveHeadersConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -509,6 +550,29 @@ resource "aws_cloudfront_response_headers_policy" "test" {
 `, rName)
 }
 
+func testAccResponseHeadersPolicyConfig_customHeader(rName, header string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_response_headers_policy" "test" {
+  name = %[1]q
+
+  custom_headers_config {
+    items {
+      header   = %[2]q
+      override = true
+      value    = "default-src 'self'; report-uri https://example.com/csp-report"
+    }
+  }
+
+  security_headers_config {
+    content_security_policy {
+      content_security_policy = "default-src 'self'"
+      override                = true
+    }
+  }
+}
+`, rName, header)
+}
+
 func testAccResponseHeadersPolicyConfig_remove(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_response_headers_policy" "test" {
@@ -29,10 +29,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newContinuousDeploymentPolicyResource,
 			Name:    "Continuous Deployment Policy",
 		},
+		{
+			Factory: newDistributionDeploymentResource,
+			Name:    "Distribution Deployment",
+		},
 		{
 			Factory: newKeyValueStoreResource,
 			Name:    "Key Value Store",
 		},
+		{
+			Factory: newStagingDistributionPromotionResource,
+			Name:    "Staging Distribution Promotion",
+		},
 	}
 }
 
@@ -56,6 +64,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudfront_function",
 			Name:     "Function",
 		},
+		{
+			Factory:  dataSourceFunctionTest,
+			TypeName: "aws_cloudfront_function_test",
+			Name:     "Function Test",
+		},
 		{
 			Factory:  dataSourceLogDeliveryCanonicalUserID,
 			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Staging Distribution Promotion")
+func newStagingDistributionPromotionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &stagingDistributionPromotionResource{}, nil
+}
+
+type stagingDistributionPromotionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[stagingDistributionPromotionResourceModel]
+}
+
+func (*stagingDistributionPromotionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_staging_distribution_promotion"
+}
+
+func (*stagingDistributionPromotionResource) Permissions(context.Context) framework.ResourcePermissions {
+	return framework.ResourcePermissions{
+		Create: []string{"cloudfront:GetDistribution", "cloudfront:UpdateDistributionWithStagingConfig"},
+	}
+}
+
+func (r *stagingDistributionPromotionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"staging_distribution_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_deployment": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *stagingDistributionPromotionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data stagingDistributionPromotionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	distributionID, stagingDistributionID := data.DistributionID.ValueString(), data.StagingDistributionID.ValueString()
+	id, err := flex.FlattenResourceId([]string{distributionID, stagingDistributionID}, stagingDistributionPromotionResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFront Staging Distribution Promotion", err.Error())
+
+		return
+	}
+
+	etag, err := distroETag(ctx, conn, distributionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("promoting CloudFront Staging Distribution (%s)", id), err.Error())
+
+		return
+	}
+
+	stagingETag, err := distroETag(ctx, conn, stagingDistributionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("promoting CloudFront Staging Distribution (%s)", id), err.Error())
+
+		return
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id: aws.String(distributionID),
+		// The ETag values of both the primary and the staging distribution, separated by a comma.
+		IfMatch:               aws.String(etag + ", " + stagingETag),
+		StagingDistributionId: aws.String(stagingDistributionID),
+	}
+
+	output, err := conn.UpdateDistributionWithStagingConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("promoting CloudFront Staging Distribution (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	data.ID = types.StringValue(id)
+
+	if data.WaitForDeployment.ValueBool() {
+		if _, err := waitDistributionDeployed(ctx, conn, distributionID); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) deploy", distributionID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stagingDistributionPromotionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data stagingDistributionPromotionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	_, err := findDistributionByID(ctx, conn, data.DistributionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Distribution (%s)", data.DistributionID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stagingDistributionPromotionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// A promotion can't be undone. Removing the resource only removes it from state.
+}
+
+const (
+	stagingDistributionPromotionResourceIDPartCount = 2
+)
+
+type stagingDistributionPromotionResourceModel struct {
+	DistributionID        types.String        `tfsdk:"distribution_id"`
+	ETag                  types.String        `tfsdk:"etag"`
+	ID                    types.String        `tfsdk:"id"`
+	StagingDistributionID types.String        `tfsdk:"staging_distribution_id"`
+	Triggers              fwtypes.MapOfString `tfsdk:"triggers"`
+	WaitForDeployment     types.Bool          `tfsdk:"wait_for_deployment"`
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontStagingDistributionPromotion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var productionDistribution awstypes.Distribution
+	resourceName := "aws_cloudfront_staging_distribution_promotion.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_init(defaultDomain),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(),
+			},
+			{
+				Config: testAccStagingDistributionPromotionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", productionDistributionResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_id", stagingDistributionResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccStagingDistributionPromotionConfig_basic() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_basic(), `
+resource "aws_cloudfront_staging_distribution_promotion" "test" {
+  distribution_id         = aws_cloudfront_distribution.test.id
+  staging_distribution_id = aws_cloudfront_distribution.staging.id
+
+  triggers = {
+    staging_etag = aws_cloudfront_distribution.staging.etag
+  }
+}
+`)
+}
@@ -44,6 +44,14 @@ func (*keyResource) Metadata(_ context.Context, request resource.MetadataRequest
 	response.TypeName = "aws_cloudfrontkeyvaluestore_key"
 }
 
+func (*keyResource) Permissions(context.Context) framework.ResourcePermissions {
+	return framework.ResourcePermissions{
+		Create: []string{"cloudfront-keyvaluestore:DescribeKeyValueStore", "cloudfront-keyvaluestore:PutKey"},
+		Update: []string{"cloudfront-keyvaluestore:DescribeKeyValueStore", "cloudfront-keyvaluestore:PutKey"},
+		Delete: []string{"cloudfront-keyvaluestore:DescribeKeyValueStore", "cloudfront-keyvaluestore:DeleteKey"},
+	}
+}
+
 func (r *keyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Keys Exclusive")
+func newKeysExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keysExclusiveResource{}
+
+	return r, nil
+}
+
+const (
+	// UpdateKeys accepts at most 50 puts and deletes combined per request.
+	defaultKeysExclusiveMaxBatchSize = 50
+)
+
+type keysExclusiveResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*keysExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_keys_exclusive"
+}
+
+func (*keysExclusiveResource) Permissions(context.Context) framework.ResourcePermissions {
+	actions := []string{
+		"cloudfront-keyvaluestore:DescribeKeyValueStore",
+		"cloudfront-keyvaluestore:ListKeys",
+		"cloudfront-keyvaluestore:UpdateKeys",
+	}
+
+	return framework.ResourcePermissions{
+		Create: actions,
+		Update: actions,
+		Delete: []string{"cloudfront-keyvaluestore:DescribeKeyValueStore", "cloudfront-keyvaluestore:UpdateKeys"},
+	}
+}
+
+func (r *keysExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_value_store_arn": schema.StringAttribute{
+				CustomType:          fwtypes.ARNType,
+				Required:            true,
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the Key Value Store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_batch_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultKeysExclusiveMaxBatchSize),
+				MarkdownDescription: "Maximum number of key puts and deletes sent in a single UpdateKeys request.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, defaultKeysExclusiveMaxBatchSize),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total size of the Key Value Store in bytes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_key_value_pair": schema.SetNestedBlock{
+				CustomType:          fwtypes.NewSetNestedObjectTypeOf[resourceKeyValuePairModel](ctx),
+				MarkdownDescription: "Key value pairs managed by the resource. Keys in the Key Value Store that are not configured here are removed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The key to put.",
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value to put.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keysExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.syncKeys(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keysExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+	output, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	keys, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s) keys", kvsARN), err.Error())
+
+		return
+	}
+
+	pairs := make([]resourceKeyValuePairModel, 0, len(keys))
+	for _, v := range keys {
+		pairs = append(pairs, resourceKeyValuePairModel{
+			Key:   fwflex.StringToFramework(ctx, v.Key),
+			Value: fwflex.StringToFramework(ctx, v.Value),
+		})
+	}
+
+	data.ResourceKeyValuePair = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, pairs)
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+	if data.MaxBatchSize.IsNull() {
+		data.MaxBatchSize = types.Int64Value(defaultKeysExclusiveMaxBatchSize)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keysExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.ResourceKeyValuePair.Equal(old.ResourceKeyValuePair) {
+		response.Diagnostics.Append(r.syncKeys(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.TotalSizeInBytes = old.TotalSizeInBytes
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keysExclusiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keysExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+
+	// Deleting keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	pairs, diags := data.ResourceKeyValuePair.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deletes := make([]awstypes.DeleteKeyRequestListItem, 0, len(pairs))
+	for _, v := range pairs {
+		deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+			Key: fwflex.StringFromFramework(ctx, v.Key),
+		})
+	}
+
+	_, err := updateKeys(ctx, conn, kvsARN, nil, deletes, int(data.MaxBatchSize.ValueInt64()))
+
+	if tfresource.NotFound(err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore (%s) keys", kvsARN), err.Error())
+
+		return
+	}
+}
+
+func (r *keysExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key_value_store_arn"), request, response)
+}
+
+// syncKeys makes the keys of the Key Value Store match the configured key value pairs.
+func (r *keysExclusiveResource) syncKeys(ctx context.Context, data *keysExclusiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+
+	// Updating keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	pairs, d := data.ResourceKeyValuePair.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	keys, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s) keys", kvsARN), err.Error())
+
+		return diags
+	}
+
+	existing := make(map[string]string, len(keys))
+	for _, v := range keys {
+		existing[aws.ToString(v.Key)] = aws.ToString(v.Value)
+	}
+
+	var puts []awstypes.PutKeyRequestListItem
+	configured := make(map[string]struct{}, len(pairs))
+	for _, v := range pairs {
+		key, value := v.Key.ValueString(), v.Value.ValueString()
+		configured[key] = struct{}{}
+
+		if old, ok := existing[key]; ok && old == value {
+			continue
+		}
+
+		puts = append(puts, awstypes.PutKeyRequestListItem{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	var deletes []awstypes.DeleteKeyRequestListItem
+	for key := range existing {
+		if _, ok := configured[key]; !ok {
+			deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+				Key: aws.String(key),
+			})
+		}
+	}
+
+	output, err := updateKeys(ctx, conn, kvsARN, puts, deletes, int(data.MaxBatchSize.ValueInt64()))
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("updating CloudFront KeyValueStore (%s) keys", kvsARN), err.Error())
+
+		return diags
+	}
+
+	if output != nil {
+		data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+	} else {
+		kvs, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s)", kvsARN), err.Error())
+
+			return diags
+		}
+
+		data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, kvs.TotalSizeInBytes)
+	}
+
+	return diags
+}
+
+// updateKeys sends the puts and deletes in batches of at most batchSize items.
+// The output of the last UpdateKeys call is returned, or nil if nothing was sent.
+func updateKeys(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string, puts []awstypes.PutKeyRequestListItem, deletes []awstypes.DeleteKeyRequestListItem, batchSize int) (*cloudfrontkeyvaluestore.UpdateKeysOutput, error) {
+	var output *cloudfrontkeyvaluestore.UpdateKeysOutput
+
+	if len(puts) == 0 && len(deletes) == 0 {
+		return output, nil
+	}
+
+	etag, err := findETagByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for len(puts) > 0 || len(deletes) > 0 {
+		input := &cloudfrontkeyvaluestore.UpdateKeysInput{
+			IfMatch: etag,
+			KvsARN:  aws.String(kvsARN),
+		}
+
+		n := min(batchSize, len(puts))
+		input.Puts, puts = puts[:n], puts[n:]
+		n = min(batchSize-n, len(deletes))
+		input.Deletes, deletes = deletes[:n], deletes[n:]
+
+		output, err = conn.UpdateKeys(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Each update returns the ETag to use for the next one.
+		etag = output.ETag
+	}
+
+	return output, nil
+}
+
+func findKeyValueStoreByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*cloudfrontkeyvaluestore.DescribeKeyValueStoreOutput, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findKeysByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) ([]awstypes.ListKeysResponseListItem, error) {
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN: aws.String(arn),
+	}
+	var output []awstypes.ListKeysResponseListItem
+
+	pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type keysExclusiveResourceModel struct {
+	KvsARN               fwtypes.ARN                                               `tfsdk:"key_value_store_arn"`
+	MaxBatchSize         types.Int64                                               `tfsdk:"max_batch_size"`
+	ResourceKeyValuePair fwtypes.SetNestedObjectValueOf[resourceKeyValuePairModel] `tfsdk:"resource_key_value_pair"`
+	TotalSizeInBytes     types.Int64                                               `tfsdk:"total_size_in_bytes"`
+}
+
+type resourceKeyValuePairModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfrontkeyvaluestore "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfrontkeyvaluestore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, 2, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "key_value_store_arn", "aws_cloudfront_key_value_store.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "key_value_store_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "key_value_store_arn",
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeysExclusive_batched(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, 12, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 12),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "12"),
+				),
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, 3, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+// Keys added outside of Terraform are removed on the next apply.
+func TestAccCloudFrontKeyValueStoreKeysExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, 1, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccKeysExclusiveConfig_outOfBandKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKeysExclusiveConfig_basic(rName, 1, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExclusiveKeyCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeysExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfrontkeyvaluestore_keys_exclusive" {
+				continue
+			}
+
+			keys, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.Attributes["key_value_store_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(keys) > 0 {
+				return fmt.Errorf("CloudFront KeyValueStore %s still has %d keys", rs.Primary.Attributes["key_value_store_arn"], len(keys))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeysExclusiveKeyCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		keys, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.Attributes["key_value_store_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(keys); got != want {
+			return fmt.Errorf("CloudFront KeyValueStore %s has %d keys, want %d", rs.Primary.Attributes["key_value_store_arn"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccKeysExclusiveConfig_basic(rName string, count, maxBatchSize int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfrontkeyvaluestore_keys_exclusive" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+  max_batch_size      = %[3]d
+
+  dynamic "resource_key_value_pair" {
+    for_each = range(%[2]d)
+
+    content {
+      key   = "key${resource_key_value_pair.value}"
+      value = "value${resource_key_value_pair.value}"
+    }
+  }
+}
+`, rName, count, maxBatchSize)
+}
+
+func testAccKeysExclusiveConfig_outOfBandKey(rName string) string {
+	return acctest.ConfigCompose(testAccKeysExclusiveConfig_basic(rName, 1, 50), `
+resource "aws_cloudfrontkeyvaluestore_key" "test" {
+  key                 = "out-of-band"
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+  value               = "out-of-band"
+
+  depends_on = [aws_cloudfrontkeyvaluestore_keys_exclusive.test]
+}
+`)
+}
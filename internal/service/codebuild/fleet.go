@@ -49,6 +49,35 @@ func resourceFleet() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"compute_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"machine_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.MachineType](),
+						},
+						"memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"vcpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"compute_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -211,6 +240,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("compute_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ComputeConfiguration = expandComputeConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("fleet_service_role"); ok {
 		input.FleetServiceRole = aws.String(v.(string))
 	}
@@ -271,6 +304,13 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.Set(names.AttrARN, fleet.Arn)
 	d.Set("base_capacity", fleet.BaseCapacity)
+	if fleet.ComputeConfiguration != nil {
+		if err := d.Set("compute_configuration", []interface{}{flattenComputeConfiguration(fleet.ComputeConfiguration)}); err != nil {
+			return create.AppendDiagError(diags, names.CodeBuild, create.ErrActionSetting, resNameFleet, d.Id(), err)
+		}
+	} else {
+		d.Set("compute_configuration", nil)
+	}
 	d.Set("compute_type", fleet.ComputeType)
 	d.Set("created", aws.ToTime(fleet.Created).Format(time.RFC3339))
 	d.Set("environment_type", fleet.EnvironmentType)
@@ -315,6 +355,12 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.BaseCapacity = aws.Int32(int32(d.Get("base_capacity").(int)))
 	}
 
+	if d.HasChange("compute_configuration") {
+		if v, ok := d.GetOk("compute_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ComputeConfiguration = expandComputeConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("compute_type") {
 		input.ComputeType = types.ComputeType(d.Get("compute_type").(string))
 	}
@@ -521,6 +567,32 @@ func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, t
 	return nil, err
 }
 
+func expandComputeConfiguration(tfMap map[string]interface{}) *types.ComputeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ComputeConfiguration{}
+
+	if v, ok := tfMap["disk"].(int); ok && v != 0 {
+		apiObject.Disk = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["machine_type"].(string); ok && v != "" {
+		apiObject.MachineType = types.MachineType(v)
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["vcpu"].(int); ok && v != 0 {
+		apiObject.VCpu = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
 func expandScalingConfiguration(tfMap map[string]interface{}) *types.ScalingConfigurationInput {
 	if tfMap == nil {
 		return nil
@@ -584,6 +656,32 @@ func expandTargetTrackingScalingConfig(tfMap map[string]interface{}) *types.Targ
 	return apiObject
 }
 
+func flattenComputeConfiguration(apiObject *types.ComputeConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Disk; v != nil {
+		tfMap["disk"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.MachineType; v != "" {
+		tfMap["machine_type"] = v
+	}
+
+	if v := apiObject.Memory; v != nil {
+		tfMap["memory"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.VCpu; v != nil {
+		tfMap["vcpu"] = aws.ToInt64(v)
+	}
+
+	return tfMap
+}
+
 func flattenScalingConfiguration(apiObject *types.ScalingConfigurationOutput) map[string]interface{} {
 	if apiObject == nil {
 		return nil
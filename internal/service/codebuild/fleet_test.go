@@ -172,6 +172,43 @@ func TestAccCodeBuildFleet_computeType(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildFleet_computeConfiguration(t *testing.T) {
+	ctx := context.Background()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_computeConfiguration(rName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", "ATTRIBUTE_BASED_COMPUTE"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.machine_type", "GENERAL"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.vcpu", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_computeConfiguration(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.vcpu", "8"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildFleet_environmentType(t *testing.T) {
 	ctx := context.Background()
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -386,6 +423,23 @@ resource "aws_codebuild_fleet" "test" {
 `, rName, string(computeType))
 }
 
+func testAccFleetConfig_computeConfiguration(rName string, vcpu int) string {
+	return fmt.Sprintf(`
+resource "aws_codebuild_fleet" "test" {
+  base_capacity     = 1
+  compute_type      = "ATTRIBUTE_BASED_COMPUTE"
+  environment_type  = "LINUX_CONTAINER"
+  name              = %[1]q
+  overflow_behavior = "ON_DEMAND"
+
+  compute_configuration {
+    machine_type = "GENERAL"
+    vcpu         = %[2]d
+  }
+}
+`, rName, vcpu)
+}
+
 func testAccFleetConfig_environmentType(rName string, environmentType types.EnvironmentType) string {
 	return fmt.Sprintf(`
 resource "aws_codebuild_fleet" "test" {
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Repository links require a connection that has completed the provider handshake,
+// which cannot be done from within a test run.
+const (
+	envVarConnectionARN  = "CODECONNECTIONS_CONNECTION_ARN"
+	envVarRepositoryPath = "CODECONNECTIONS_REPOSITORY_PATH" // "owner/name"
+)
+
+func testAccRepositoryLinkPreCheck(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryPath := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryPath)
+
+	owner, name, ok := strings.Cut(repositoryPath, "/")
+	if !ok {
+		t.Fatalf("environment variable %s must be in the form owner/name", envVarRepositoryPath)
+	}
+
+	return connectionARN, owner, name
+}
+
+func TestAccCodeConnectionsRepositoryLink_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN, owner, name := testAccRepositoryLinkPreCheck(t)
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "codeconnections", regexache.MustCompile("repository-link/.+")),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckNoResourceAttr(resourceName, "encryption_key_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, owner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, name),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN, owner, name := testAccRepositoryLinkPreCheck(t)
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceRepositoryLink, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryLinkExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		_, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckRepositoryLinkDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_repository_link" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Repository Link %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRepositoryLinkConfig_basic(connectionARN, owner, name string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+}
+`, connectionARN, owner, name)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeConnectionsSyncConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN, owner, name := testAccRepositoryLinkPreCheck(t)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, name, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "config_file", "deployment.yaml"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, owner),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", "aws_codeconnections_repository_link.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, name),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", string(awstypes.SyncConfigurationTypeCfnStackSync)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, name, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsSyncConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN, owner, name := testAccRepositoryLinkPreCheck(t)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, name, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceSyncConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSyncConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		_, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], awstypes.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+		return err
+	}
+}
+
+func testAccCheckSyncConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_sync_configuration" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], awstypes.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Sync Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, name, publishDeploymentStatus string) string {
+	return acctest.ConfigCompose(testAccRepositoryLinkConfig_basic(connectionARN, owner, name), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudformation.sync.codeconnections.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_codeconnections_sync_configuration" "test" {
+  branch                    = "main"
+  config_file               = "deployment.yaml"
+  publish_deployment_status = %[2]q
+  repository_link_id        = aws_codeconnections_repository_link.test.id
+  resource_name             = %[1]q
+  role_arn                  = aws_iam_role.test.arn
+  sync_type                 = "CFN_STACK_SYNC"
+}
+`, rName, publishDeploymentStatus))
+}
@@ -89,6 +89,10 @@ func TestAccConnect_serial(t *testing.T) {
 			"prefix":             testAccPhoneNumber_prefix,
 			"targetARN":          testAccPhoneNumber_targetARN,
 		},
+		"PredefinedAttribute": {
+			acctest.CtBasic:      testAccPredefinedAttribute_basic,
+			acctest.CtDisappears: testAccPredefinedAttribute_disappears,
+		},
 		"Prompt": {
 			"dataSource_name": testAccPromptDataSource_name,
 		},
@@ -138,6 +142,7 @@ func TestAccConnect_serial(t *testing.T) {
 			"hierarchyGroupId":   testAccUser_updateHierarchyGroupId,
 			"identityInfo":       testAccUser_updateIdentityInfo,
 			"phoneConfig":        testAccUser_updatePhoneConfig,
+			"proficiency":        testAccUser_updateProficiency,
 			"routingProfileId":   testAccUser_updateRoutingProfileId,
 			"securityProfileIds": testAccUser_updateSecurityProfileIds,
 			"dataSource_id":      testAccUserDataSource_userID,
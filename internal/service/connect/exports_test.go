@@ -13,6 +13,7 @@ var (
 	ResourceInstanceStorageConfig     = resourceInstanceStorageConfig
 	ResourceLambdaFunctionAssociation = resourceLambdaFunctionAssociation
 	ResourcePhoneNumber               = resourcePhoneNumber
+	ResourcePredefinedAttribute       = resourcePredefinedAttribute
 	ResourceQueue                     = resourceQueue
 	ResourceQuickConnect              = resourceQuickConnect
 	ResourceRoutingProfile            = resourceRoutingProfile
@@ -30,6 +31,7 @@ var (
 	FindInstanceStorageConfigByThreePartKey   = findInstanceStorageConfigByThreePartKey
 	FindLambdaFunctionAssociationByTwoPartKey = findLambdaFunctionAssociationByTwoPartKey
 	FindPhoneNumberByID                       = findPhoneNumberByID
+	FindPredefinedAttributeByTwoPartKey       = findPredefinedAttributeByTwoPartKey
 	FindQueueByTwoPartKey                     = findQueueByTwoPartKey
 	FindQuickConnectByTwoPartKey              = findQuickConnectByTwoPartKey
 	FindRoutingProfileByTwoPartKey            = findRoutingProfileByTwoPartKey
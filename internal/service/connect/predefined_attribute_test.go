@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPredefinedAttribute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "Spanish"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_region"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "Spanish"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "French", "German"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "French"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "German"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttribute_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourcePredefinedAttribute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPredefinedAttributeExists(ctx context.Context, n string, v *awstypes.PredefinedAttribute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+		output, err := tfconnect.FindPredefinedAttributeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPredefinedAttributeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_predefined_attribute" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+			_, err := tfconnect.FindPredefinedAttributeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Predefined Attribute %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPredefinedAttributeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccPredefinedAttributeConfig_basic(rName, rName2, values string) string {
+	return acctest.ConfigCompose(
+		testAccPredefinedAttributeConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  values      = [%[2]s]
+}
+`, rName2, values))
+}
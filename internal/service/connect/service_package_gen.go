@@ -174,6 +174,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourcePredefinedAttribute,
+			TypeName: "aws_connect_predefined_attribute",
+			Name:     "Predefined Attribute",
+		},
 		{
 			Factory:  resourceQueue,
 			TypeName: "aws_connect_queue",
@@ -131,6 +131,30 @@ func resourceUser() *schema.Resource {
 					},
 				},
 			},
+			"proficiency": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"attribute_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"level": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(1.0, 5.0),
+						},
+					},
+				},
+			},
 			"routing_profile_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -191,9 +215,24 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "creating Connect User (%s): %s", name, err)
 	}
 
-	id := userCreateResourceID(instanceID, aws.ToString(output.UserId))
+	userID := aws.ToString(output.UserId)
+	id := userCreateResourceID(instanceID, userID)
 	d.SetId(id)
 
+	if v, ok := d.GetOk("proficiency"); ok && v.(*schema.Set).Len() > 0 {
+		input := &connect.AssociateUserProficienciesInput{
+			InstanceId:        aws.String(instanceID),
+			UserId:            aws.String(userID),
+			UserProficiencies: expandUserProficiencies(v.(*schema.Set).List()),
+		}
+
+		_, err := conn.AssociateUserProficiencies(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "associating Connect User (%s) proficiencies: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
@@ -229,6 +268,16 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("phone_config", flattenUserPhoneConfig(user.PhoneConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting phone_config: %s", err)
 	}
+
+	proficiencies, err := findUserProficienciesByTwoPartKey(ctx, conn, instanceID, userID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect User (%s) proficiencies: %s", d.Id(), err)
+	}
+
+	if err := d.Set("proficiency", flattenUserProficiencies(proficiencies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting proficiency: %s", err)
+	}
 	d.Set("routing_profile_id", user.RoutingProfileId)
 	d.Set("security_profile_ids", user.SecurityProfileIds)
 	d.Set("user_id", user.Id)
@@ -253,6 +302,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	// UpdateUserPhoneConfigWithContext: Updates the phone configuration settings for the specified user.
 	// UpdateUserRoutingProfileWithContext: Assigns the specified routing profile to the specified user.
 	// UpdateUserSecurityProfilesWithContext: Assigns the specified security profiles to the specified user.
+	// Proficiencies are managed separately via Associate/Update/DisassociateUserProficiencies.
 
 	// updates to hierarchy_group_id
 	if d.HasChange("hierarchy_group_id") {
@@ -332,6 +382,54 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	// updates to proficiency
+	if d.HasChange("proficiency") {
+		o, n := d.GetChange("proficiency")
+		add, update, del := userProficienciesDiff(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		if len(del) > 0 {
+			input := &connect.DisassociateUserProficienciesInput{
+				InstanceId:        aws.String(instanceID),
+				UserId:            aws.String(userID),
+				UserProficiencies: del,
+			}
+
+			_, err = conn.DisassociateUserProficiencies(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Connect User (%s) proficiencies: %s", d.Id(), err)
+			}
+		}
+
+		if len(update) > 0 {
+			input := &connect.UpdateUserProficienciesInput{
+				InstanceId:        aws.String(instanceID),
+				UserId:            aws.String(userID),
+				UserProficiencies: update,
+			}
+
+			_, err = conn.UpdateUserProficiencies(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Connect User (%s) proficiencies: %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &connect.AssociateUserProficienciesInput{
+				InstanceId:        aws.String(instanceID),
+				UserId:            aws.String(userID),
+				UserProficiencies: add,
+			}
+
+			_, err = conn.AssociateUserProficiencies(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Connect User (%s) proficiencies: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
@@ -410,6 +508,39 @@ func findUser(ctx context.Context, conn *connect.Client, input *connect.Describe
 	return output.User, nil
 }
 
+func findUserProficienciesByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, userID string) ([]awstypes.UserProficiency, error) {
+	input := &connect.ListUserProficienciesInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	}
+
+	return findUserProficiencies(ctx, conn, input)
+}
+
+func findUserProficiencies(ctx context.Context, conn *connect.Client, input *connect.ListUserProficienciesInput) ([]awstypes.UserProficiency, error) {
+	var output []awstypes.UserProficiency
+
+	pages := connect.NewListUserProficienciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.UserProficiencyList...)
+	}
+
+	return output, nil
+}
+
 func expandUserIdentityInfo(tfList []interface{}) *awstypes.UserIdentityInfo {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -505,3 +636,74 @@ func flattenUserPhoneConfig(apiObject *awstypes.UserPhoneConfig) []interface{} {
 
 	return []interface{}{tfMap}
 }
+
+func expandUserProficiencies(tfList []interface{}) []awstypes.UserProficiency {
+	apiObjects := []awstypes.UserProficiency{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.UserProficiency{
+			AttributeName:  aws.String(tfMap["attribute_name"].(string)),
+			AttributeValue: aws.String(tfMap["attribute_value"].(string)),
+			Level:          aws.Float32(float32(tfMap["level"].(float64))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenUserProficiencies(apiObjects []awstypes.UserProficiency) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_name":  aws.ToString(apiObject.AttributeName),
+			"attribute_value": aws.ToString(apiObject.AttributeValue),
+			"level":           float64(aws.ToFloat32(apiObject.Level)),
+		})
+	}
+
+	return tfList
+}
+
+// userProficienciesDiff returns the proficiencies to associate, update and disassociate.
+// A proficiency is identified by its attribute name and value; a change in level alone is an update.
+func userProficienciesDiff(o, n []interface{}) ([]awstypes.UserProficiency, []awstypes.UserProficiency, []awstypes.UserProficiencyDisassociate) {
+	key := func(apiObject awstypes.UserProficiency) string {
+		return aws.ToString(apiObject.AttributeName) + userResourceIDSeparator + aws.ToString(apiObject.AttributeValue)
+	}
+
+	oldProficiencies := make(map[string]awstypes.UserProficiency)
+	for _, apiObject := range expandUserProficiencies(o) {
+		oldProficiencies[key(apiObject)] = apiObject
+	}
+
+	var add, update []awstypes.UserProficiency
+	newProficiencies := make(map[string]awstypes.UserProficiency)
+	for _, apiObject := range expandUserProficiencies(n) {
+		k := key(apiObject)
+		newProficiencies[k] = apiObject
+
+		if old, ok := oldProficiencies[k]; !ok {
+			add = append(add, apiObject)
+		} else if aws.ToFloat32(old.Level) != aws.ToFloat32(apiObject.Level) {
+			update = append(update, apiObject)
+		}
+	}
+
+	var del []awstypes.UserProficiencyDisassociate
+	for k, apiObject := range oldProficiencies {
+		if _, ok := newProficiencies[k]; !ok {
+			del = append(del, awstypes.UserProficiencyDisassociate{
+				AttributeName:  apiObject.AttributeName,
+				AttributeValue: apiObject.AttributeValue,
+			})
+		}
+	}
+
+	return add, update, del
+}
@@ -289,6 +289,75 @@ func testAccUser_updateSecurityProfileIds(t *testing.T) {
 	})
 }
 
+func testAccUser_updateProficiency(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.User
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName6 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_proficiency(rName, rName2, rName3, rName4, rName5, rName6, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "proficiency.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "proficiency.*", map[string]string{
+						"attribute_name":  rName6,
+						"attribute_value": "English",
+						"level":           "3",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
+			{
+				Config: testAccUserConfig_proficiency(rName, rName2, rName3, rName4, rName5, rName6, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "proficiency.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "proficiency.*", map[string]string{
+						"attribute_name":  rName6,
+						"attribute_value": "English",
+						"level":           "5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "proficiency.*", map[string]string{
+						"attribute_name":  rName6,
+						"attribute_value": "Spanish",
+						"level":           "1",
+					}),
+				),
+			},
+			{
+				Config: testAccUserConfig_proficiency(rName, rName2, rName3, rName4, rName5, rName6, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "proficiency.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "proficiency.*", map[string]string{
+						"attribute_name":  rName6,
+						"attribute_value": "Spanish",
+						"level":           "2.5",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccUser_updateRoutingProfileId(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.User
@@ -803,3 +872,61 @@ resource "aws_connect_user" "test" {
 }
 `, rName5))
 }
+
+func testAccUserConfig_proficiency(rName, rName2, rName3, rName4, rName5, rName6, selectProficiencies string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_base(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+locals {
+  proficiencies = {
+    "first" = [
+      { value = "English", level = 3 },
+    ]
+    "second" = [
+      { value = "English", level = 5 },
+      { value = "Spanish", level = 1 },
+    ]
+    "third" = [
+      { value = "Spanish", level = 2.5 },
+    ]
+  }
+}
+
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[2]q
+  values      = ["English", "Spanish"]
+}
+
+resource "aws_connect_user" "test" {
+  instance_id        = aws_connect_instance.test.id
+  name               = %[1]q
+  password           = "Password123"
+  routing_profile_id = data.aws_connect_routing_profile.test.routing_profile_id
+
+  security_profile_ids = [
+    data.aws_connect_security_profile.agent.security_profile_id
+  ]
+
+  identity_info {
+    first_name = "example"
+    last_name  = "example2"
+  }
+
+  phone_config {
+    after_contact_work_time_limit = 0
+    phone_type                    = "SOFT_PHONE"
+  }
+
+  dynamic "proficiency" {
+    for_each = local.proficiencies[%[3]q]
+
+    content {
+      attribute_name  = aws_connect_predefined_attribute.test.name
+      attribute_value = proficiency.value.value
+      level           = proficiency.value.level
+    }
+  }
+}
+`, rName5, rName6, selectProficiencies))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcontroltower "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The ARN of the enabled IdentityCenterBaseline on the landing zone's home organizational unit.
+	envVarIdentityCenterEnabledBaselineARN = "CONTROLTOWER_IDENTITY_CENTER_ENABLED_BASELINE_ARN"
+)
+
+func TestAccControlTowerBaseline_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Baseline": {
+			acctest.CtBasic:      testAccBaseline_basic,
+			acctest.CtDisappears: testAccBaseline_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccBaseline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var baseline types.EnabledBaselineDetails
+	resourceName := "aws_controltower_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	identityCenterEnabledBaselineARN := acctest.SkipIfEnvVarNotSet(t, envVarIdentityCenterEnabledBaselineARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckBaselineDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBaselineConfig_basic(rName, identityCenterEnabledBaselineARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBaselineExists(ctx, resourceName, &baseline),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "baseline_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "baseline_version", "4.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_identifier", "aws_organizations_organizational_unit.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBaseline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var baseline types.EnabledBaselineDetails
+	resourceName := "aws_controltower_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	identityCenterEnabledBaselineARN := acctest.SkipIfEnvVarNotSet(t, envVarIdentityCenterEnabledBaselineARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckBaselineDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBaselineConfig_basic(rName, identityCenterEnabledBaselineARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaselineExists(ctx, resourceName, &baseline),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcontroltower.ResourceBaseline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBaselineExists(ctx context.Context, n string, v *types.EnabledBaselineDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		output, err := tfcontroltower.FindEnabledBaselineByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBaselineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_controltower_baseline" {
+				continue
+			}
+
+			_, err := tfcontroltower.FindEnabledBaselineByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ControlTower Baseline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccBaselineConfig_basic(rName, identityCenterEnabledBaselineARN string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_organizations_organization" "current" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = data.aws_organizations_organization.current.roots[0].id
+}
+
+resource "aws_controltower_baseline" "test" {
+  baseline_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::baseline/17BSJV3IGJ2QSGA2"
+  baseline_version    = "4.0"
+  target_identifier   = aws_organizations_organizational_unit.test.arn
+
+  parameters {
+    key   = "IdentityCenterEnabledBaselineArn"
+    value = jsonencode(%[2]q)
+  }
+}
+`, rName, identityCenterEnabledBaselineARN)
+}
@@ -436,7 +436,7 @@ func statusControlOperation(ctx context.Context, conn *controltower.Client, id s
 			return nil, "", err
 		}
 
-		return output, aws.ToString((*string)(&output.Status)), nil
+		return output, string(output.Status), nil
 	}
 }
 
@@ -450,10 +450,12 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.Client, id s
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*controltower.GetControlOperationOutput); ok {
-		tfresource.SetLastError(err, errors.New(aws.ToString(output.ControlOperation.StatusMessage)))
+	if output, ok := outputRaw.(*types.ControlOperation); ok {
+		if status := output.Status; status == types.ControlOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
 
-		return output.ControlOperation, err
+		return output, err
 	}
 
 	return nil, err
@@ -207,25 +207,46 @@ func resourceGatewayAssociationUpdate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
 
 	associationID := d.Get("dx_gateway_association_id").(string)
-	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
-		AssociationId: aws.String(associationID),
-	}
 
-	o, n := d.GetChange("allowed_prefixes")
-	os, ns := o.(*schema.Set), n.(*schema.Set)
+	// A new proposal from the associated gateway owner account is accepted in place, overriding the allowed prefixes.
+	if associatedGatewayOwnerAccount := d.Get("associated_gateway_owner_account_id").(string); associatedGatewayOwnerAccount != "" && d.HasChange("proposal_id") {
+		proposalID := d.Get("proposal_id").(string)
+		input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
+			AssociatedGatewayOwnerAccount: aws.String(associatedGatewayOwnerAccount),
+			DirectConnectGatewayId:        aws.String(d.Get("dx_gateway_id").(string)),
+			ProposalId:                    aws.String(proposalID),
+		}
 
-	if add := ns.Difference(os); add.Len() > 0 {
-		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
-	}
+		if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+			input.OverrideAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(v.(*schema.Set).List())
+		}
 
-	if del := os.Difference(ns); del.Len() > 0 {
-		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
-	}
+		_, err := conn.AcceptDirectConnectGatewayAssociationProposal(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "accepting Direct Connect Gateway Association Proposal (%s): %s", proposalID, err)
+		}
+	} else {
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+		}
 
-	_, err := conn.UpdateDirectConnectGatewayAssociation(ctx, input)
+		o, n := d.GetChange("allowed_prefixes")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+		}
+
+		_, err := conn.UpdateDirectConnectGatewayAssociation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+		}
 	}
 
 	if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -268,6 +268,18 @@ const (
 	sriovNetSupportSimple = "simple"
 )
 
+const (
+	instanceUpdateBehaviorReplace       = "replace"
+	instanceUpdateBehaviorStopAndModify = "stop_and_modify"
+)
+
+func instanceUpdateBehavior_Values() []string {
+	return []string{
+		instanceUpdateBehaviorReplace,
+		instanceUpdateBehaviorStopAndModify,
+	}
+}
+
 const (
 	targetStorageTierStandard awstypes.TargetStorageTier = "standard"
 )
@@ -156,14 +156,12 @@ func resourceInstance() *schema.Resource {
 							Type:          schema.TypeInt,
 							Optional:      true,
 							Computed:      true,
-							ForceNew:      true,
 							ConflictsWith: []string{"cpu_core_count"},
 						},
 						"threads_per_core": {
 							Type:          schema.TypeInt,
 							Optional:      true,
 							Computed:      true,
-							ForceNew:      true,
 							ConflictsWith: []string{"cpu_threads_per_core"},
 						},
 					},
@@ -173,7 +171,6 @@ func resourceInstance() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				Deprecated:    "use 'cpu_options' argument instead",
 				ConflictsWith: []string{"cpu_options.0.core_count"},
 			},
@@ -181,7 +178,6 @@ func resourceInstance() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				Deprecated:    "use 'cpu_options' argument instead",
 				ConflictsWith: []string{"cpu_options.0.threads_per_core"},
 			},
@@ -314,6 +310,11 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"enclave_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -798,6 +799,11 @@ func resourceInstance() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.Tenancy](),
 			},
+			"update_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(instanceUpdateBehavior_Values(), false),
+			},
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -941,10 +947,21 @@ func resourceInstance() *schema.Resource {
 
 				return true
 			}),
+			// cpu_options and ena_support can only be changed on a stopped instance.
+			// Unless the stop_and_modify update behavior is selected, changes force replacement.
+			customdiff.ForceNewIf("cpu_core_count", instanceUpdateRequiresReplacement),
+			customdiff.ForceNewIf("cpu_threads_per_core", instanceUpdateRequiresReplacement),
+			customdiff.ForceNewIf("cpu_options.0.core_count", instanceUpdateRequiresReplacement),
+			customdiff.ForceNewIf("cpu_options.0.threads_per_core", instanceUpdateRequiresReplacement),
+			customdiff.ForceNewIf("ena_support", instanceUpdateRequiresReplacement),
 		),
 	}
 }
 
+func instanceUpdateRequiresReplacement(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+	return diff.Id() != "" && diff.Get("update_behavior").(string) != instanceUpdateBehaviorStopAndModify
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1, io2, or gp3 and iops is unset or configured as 0
 	i := strings.LastIndexByte(k, '.')
@@ -1121,7 +1138,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	instance, err := findInstanceByID(ctx, conn, d.Id())
+	instance, err := findInstanceByIDBatched(ctx, meta.(*conns.AWSClient), d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Instance %s not found, removing from state", d.Id())
@@ -1158,6 +1175,8 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("tenancy", v.Tenancy)
 	}
 
+	d.Set("ena_support", instance.EnaSupport)
+
 	// preserved to maintain backward compatibility
 	if v := instance.CpuOptions; v != nil {
 		d.Set("cpu_core_count", v.CoreCount)
@@ -1775,12 +1794,70 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if hasInstanceStopAndModifyChanges(d) && !d.IsNewResource() {
+		// Only reachable with update_behavior = "stop_and_modify", otherwise these changes force replacement.
+		// Apply all changes, including any instance type change, during a single stop/start cycle.
+		if err := stopInstance(ctx, conn, d.Id(), false, instanceStopTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+
+		if d.HasChange(names.AttrInstanceType) && !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
+			input := &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &awstypes.AttributeValue{
+					Value: aws.String(d.Get(names.AttrInstanceType).(string)),
+				},
+			}
+
+			if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChanges("cpu_core_count", "cpu_threads_per_core", "cpu_options.0.core_count", "cpu_options.0.threads_per_core") {
+			coreCount, threadsPerCore := d.Get("cpu_core_count").(int), d.Get("cpu_threads_per_core").(int)
+			if v, ok := d.GetOk("cpu_options.0.core_count"); ok {
+				coreCount = v.(int)
+			}
+			if v, ok := d.GetOk("cpu_options.0.threads_per_core"); ok {
+				threadsPerCore = v.(int)
+			}
+
+			input := &ec2.ModifyInstanceCpuOptionsInput{
+				CoreCount:      aws.Int32(int32(coreCount)),
+				InstanceId:     aws.String(d.Id()),
+				ThreadsPerCore: aws.Int32(int32(threadsPerCore)),
+			}
+
+			if _, err := conn.ModifyInstanceCpuOptions(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) CPU options: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("ena_support") {
+			input := &ec2.ModifyInstanceAttributeInput{
+				EnaSupport: &awstypes.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ena_support").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			}
+
+			if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) EnaSupport attribute: %s", d.Id(), err)
+			}
+		}
+
+		if err := startInstance(ctx, conn, d.Id(), true, instanceStartTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChanges(names.AttrInstanceType, "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
-		if d.HasChange(names.AttrInstanceType) {
+		if d.HasChange(names.AttrInstanceType) && !hasInstanceStopAndModifyChanges(d) {
 			if !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
 				instanceType := d.Get(names.AttrInstanceType).(string)
 				input := &ec2.ModifyInstanceAttributeInput{
@@ -2214,6 +2291,12 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.Client, id str
 	return nil
 }
 
+// hasInstanceStopAndModifyChanges returns whether any arguments that can only be
+// modified on a stopped instance, other than instance_type, have changed.
+func hasInstanceStopAndModifyChanges(d *schema.ResourceData) bool {
+	return d.HasChanges("cpu_core_count", "cpu_threads_per_core", "cpu_options.0.core_count", "cpu_options.0.threads_per_core", "ena_support")
+}
+
 // modifyInstanceAttributeWithStopStart modifies a specific attribute provided
 // as input by first stopping the EC2 instance before the modification
 // and then starting up the EC2 instance after modification.
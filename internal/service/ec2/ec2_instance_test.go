@@ -4222,6 +4222,56 @@ func TestAccEC2Instance_cpuOptionsCoreThreads(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_cpuOptionsCoreThreadsStopAndModify(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	originalCoreCount := 2
+	updatedCoreCount := 3
+	originalThreadsPerCore := 2
+	updatedThreadsPerCore := 1
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_cpuOptionsCoreThreadsStopAndModify(rName, originalCoreCount, originalThreadsPerCore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.0.core_count", strconv.Itoa(originalCoreCount)),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.0.threads_per_core", strconv.Itoa(originalThreadsPerCore)),
+					resource.TestCheckResourceAttr(resourceName, "update_behavior", "stop_and_modify"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"update_behavior", "user_data_replace_on_change"},
+			},
+			{
+				// EC2 instance should be stopped and modified, not recreated
+				Config: testAccInstanceConfig_cpuOptionsCoreThreadsStopAndModify(rName, updatedCoreCount, updatedThreadsPerCore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.0.core_count", strconv.Itoa(updatedCoreCount)),
+					resource.TestCheckResourceAttr(resourceName, "cpu_options.0.threads_per_core", strconv.Itoa(updatedThreadsPerCore)),
+					resource.TestCheckResourceAttr(resourceName, "cpu_core_count", strconv.Itoa(updatedCoreCount)),
+					resource.TestCheckResourceAttr(resourceName, "cpu_threads_per_core", strconv.Itoa(updatedThreadsPerCore)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_cpuOptionsCoreThreadsMigration(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2 awstypes.Instance
@@ -5753,7 +5803,6 @@ func TestInstanceCPUCoreCountSchema(t *testing.T) {
 		Type:          schema.TypeInt,
 		Optional:      true,
 		Computed:      true,
-		ForceNew:      true,
 		Deprecated:    "use 'cpu_options' argument instead",
 		ConflictsWith: []string{"cpu_options.0.core_count"},
 	}
@@ -5773,7 +5822,6 @@ func TestInstanceCPUThreadsPerCoreSchema(t *testing.T) {
 		Type:          schema.TypeInt,
 		Optional:      true,
 		Computed:      true,
-		ForceNew:      true,
 		Deprecated:    "use 'cpu_options' argument instead",
 		ConflictsWith: []string{"cpu_options.0.threads_per_core"},
 	}
@@ -8516,6 +8564,30 @@ resource "aws_instance" "test" {
 `, rName, coreCount, threadsPerCore))
 }
 
+func testAccInstanceConfig_cpuOptionsCoreThreadsStopAndModify(rName string, coreCount, threadsPerCore int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceVPCConfig(rName, false, 0),
+		testAccLatestAmazonLinux2023AMIConfig(),
+		acctest.AvailableEC2InstanceTypeForRegion("c6a.2xlarge", "m6a.2xlarge"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami             = data.aws_ami.amzn-linux-2023-ami.id
+  instance_type   = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id       = aws_subnet.test.id
+  update_behavior = "stop_and_modify"
+
+  cpu_options {
+    core_count       = %[2]d
+    threads_per_core = %[3]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, coreCount, threadsPerCore))
+}
+
 func testAccInstanceConfig_cpuOptionsCoreThreadsDeprecated(rName string, coreCount, threadsPerCore int) string {
 	return acctest.ConfigCompose(
 		testAccInstanceVPCConfig(rName, false, 0),
@@ -28,5 +28,6 @@ var (
 	ResourceTransitGatewayConnectPeer                              = resourceTransitGatewayConnectPeer
 	ResourceVPC                                                    = resourceVPC
 	VPCEndpointCreationTimeout                                     = vpcEndpointCreationTimeout
+	WaitNetworkInterfaceAvailableAfterUse                          = waitNetworkInterfaceAvailableAfterUse
 	WaitVPCEndpointAvailable                                       = waitVPCEndpointAvailable
 )
@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -470,6 +471,81 @@ func findInstanceByID(ctx context.Context, conn *ec2.Client, id string) (*awstyp
 	return output, nil
 }
 
+// findInstanceByIDBatched is findInstanceByID with concurrent lookups coalesced into batched DescribeInstances calls.
+func findInstanceByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.Instance, error) {
+	conn := c.EC2Client(ctx)
+
+	output, ok, err := conns.Batched(ctx, c, "ec2.DescribeInstances", id, func(ctx context.Context, ids []string) (map[string]awstypes.Instance, error) {
+		instances, err := findByIDsOmittingNotFound(ctx, ids, errCodeInvalidInstanceIDNotFound, func(ctx context.Context, ids []string) ([]awstypes.Instance, error) {
+			return findInstances(ctx, conn, &ec2.DescribeInstancesInput{
+				InstanceIds: ids,
+			})
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]awstypes.Instance)
+		for _, v := range instances {
+			if v.State != nil {
+				output[aws.ToString(v.InstanceId)] = v
+			}
+		}
+
+		return output, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, &retry.NotFoundError{}
+	}
+
+	if state := output.State.Name; state == awstypes.InstanceStateNameTerminated {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return &output, nil
+}
+
+// findByIDsOmittingNotFound calls find with the specified IDs, omitting those that don't exist from the result.
+// A Describe call fails with the specified not found error code if any of the IDs doesn't exist,
+// so the IDs are split in two and each half described until those that don't exist are described on their own.
+func findByIDsOmittingNotFound[T any](ctx context.Context, ids []string, notFoundErrCode string, find func(context.Context, []string) ([]T, error)) ([]T, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	output, err := find(ctx, ids)
+
+	if !tfawserr.ErrCodeEquals(err, notFoundErrCode) {
+		return output, err
+	}
+
+	if len(ids) == 1 {
+		return nil, nil
+	}
+
+	head, err := findByIDsOmittingNotFound(ctx, ids[:len(ids)/2], notFoundErrCode, find)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tail, err := findByIDsOmittingNotFound(ctx, ids[len(ids)/2:], notFoundErrCode, find)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(head, tail...), nil
+}
+
 func findInstanceStatus(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstanceStatusInput) (*awstypes.InstanceStatus, error) {
 	output, err := findInstanceStatuses(ctx, conn, input)
 
@@ -2136,6 +2212,40 @@ func findNetworkInterfaceByID(ctx context.Context, conn *ec2.Client, id string)
 	return output, err
 }
 
+// findNetworkInterfaceByIDBatched is findNetworkInterfaceByID with concurrent lookups coalesced into batched DescribeNetworkInterfaces calls.
+func findNetworkInterfaceByIDBatched(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.NetworkInterface, error) {
+	conn := c.EC2Client(ctx)
+
+	output, ok, err := conns.Batched(ctx, c, "ec2.DescribeNetworkInterfaces", id, func(ctx context.Context, ids []string) (map[string]awstypes.NetworkInterface, error) {
+		networkInterfaces, err := findByIDsOmittingNotFound(ctx, ids, errCodeInvalidNetworkInterfaceIDNotFound, func(ctx context.Context, ids []string) ([]awstypes.NetworkInterface, error) {
+			return findNetworkInterfaces(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+				NetworkInterfaceIds: ids,
+			})
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]awstypes.NetworkInterface)
+		for _, v := range networkInterfaces {
+			output[aws.ToString(v.NetworkInterfaceId)] = v
+		}
+
+		return output, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &output, nil
+}
+
 func findNetworkInterfaceAttachmentByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInterfaceAttachment, error) {
 	input := &ec2.DescribeNetworkInterfacesInput{
 		Filters: newAttributeFilterList(map[string]string{
@@ -3576,6 +3686,49 @@ func findVPNConnectionRouteByTwoPartKey(ctx context.Context, conn *ec2.Client, v
 	}
 }
 
+func findVPNTunnelReplacementStatusByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, outsideIPAddress string) (*ec2.GetVpnTunnelReplacementStatusOutput, error) {
+	input := &ec2.GetVpnTunnelReplacementStatusInput{
+		VpnConnectionId:           aws.String(vpnConnectionID),
+		VpnTunnelOutsideIpAddress: aws.String(outsideIPAddress),
+	}
+
+	output, err := conn.GetVpnTunnelReplacementStatus(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findVPNConnectionDeviceTypes(ctx context.Context, conn *ec2.Client, input *ec2.GetVpnConnectionDeviceTypesInput) ([]awstypes.VpnConnectionDeviceType, error) {
+	var output []awstypes.VpnConnectionDeviceType
+
+	pages := ec2.NewGetVpnConnectionDeviceTypesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.VpnConnectionDeviceTypes...)
+	}
+
+	return output, nil
+}
+
 func findVPNGatewayVPCAttachmentByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnGatewayID, vpcID string) (*awstypes.VpcAttachment, error) {
 	vpnGateway, err := findVPNGatewayByID(ctx, conn, vpnGatewayID)
 
@@ -6577,3 +6730,259 @@ func findCapacityBlockOfferings(ctx context.Context, conn *ec2.Client, input *ec
 
 	return output, nil
 }
+
+func findRouteServer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServersInput) (*awstypes.RouteServer, error) {
+	output, err := findRouteServers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServersInput) ([]awstypes.RouteServer, error) {
+	var output []awstypes.RouteServer
+
+	pages := ec2.NewDescribeRouteServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServers...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServer, error) {
+	input := &ec2.DescribeRouteServersInput{
+		RouteServerIds: []string{id},
+	}
+	output, err := findRouteServer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerAssociations(ctx context.Context, conn *ec2.Client, input *ec2.GetRouteServerAssociationsInput) ([]awstypes.RouteServerAssociation, error) {
+	output, err := conn.GetRouteServerAssociations(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RouteServerAssociations, nil
+}
+
+func findRouteServerAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, routeServerID, vpcID string) (*awstypes.RouteServerAssociation, error) {
+	input := &ec2.GetRouteServerAssociationsInput{
+		RouteServerId: aws.String(routeServerID),
+	}
+	output, err := findRouteServerAssociations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v awstypes.RouteServerAssociation) bool {
+		return aws.ToString(v.VpcId) == vpcID
+	}))
+}
+
+func findRouteServerEndpoint(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerEndpointsInput) (*awstypes.RouteServerEndpoint, error) {
+	output, err := findRouteServerEndpoints(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServerEndpoints(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerEndpointsInput) ([]awstypes.RouteServerEndpoint, error) {
+	var output []awstypes.RouteServerEndpoint
+
+	pages := ec2.NewDescribeRouteServerEndpointsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerEndpointIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServerEndpoints...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerEndpointByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServerEndpoint, error) {
+	input := &ec2.DescribeRouteServerEndpointsInput{
+		RouteServerEndpointIds: []string{id},
+	}
+	output, err := findRouteServerEndpoint(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerEndpointStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerEndpointId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerPeer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerPeersInput) (*awstypes.RouteServerPeer, error) {
+	output, err := findRouteServerPeers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServerPeers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerPeersInput) ([]awstypes.RouteServerPeer, error) {
+	var output []awstypes.RouteServerPeer
+
+	pages := ec2.NewDescribeRouteServerPeersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerPeerIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServerPeers...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerPeerByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServerPeer, error) {
+	input := &ec2.DescribeRouteServerPeersInput{
+		RouteServerPeerIds: []string{id},
+	}
+	output, err := findRouteServerPeer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerPeerStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerPeerId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerPropagations(ctx context.Context, conn *ec2.Client, input *ec2.GetRouteServerPropagationsInput) ([]awstypes.RouteServerPropagation, error) {
+	output, err := conn.GetRouteServerPropagations(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RouteServerPropagations, nil
+}
+
+func findRouteServerPropagationByTwoPartKey(ctx context.Context, conn *ec2.Client, routeServerID, routeTableID string) (*awstypes.RouteServerPropagation, error) {
+	input := &ec2.GetRouteServerPropagationsInput{
+		RouteServerId: aws.String(routeServerID),
+		RouteTableId:  aws.String(routeTableID),
+	}
+	output, err := findRouteServerPropagations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v awstypes.RouteServerPropagation) bool {
+		return aws.ToString(v.RouteTableId) == routeTableID
+	}))
+}
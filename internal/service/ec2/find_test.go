@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestFindByIDsOmittingNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	existing := []string{"i-1", "i-3"}
+
+	testCases := map[string]struct {
+		errCode       string
+		ids           []string
+		expected      []string
+		expectedCalls int
+		expectedError bool
+	}{
+		"all found": {
+			ids:           []string{"i-1", "i-3"},
+			expected:      []string{"i-1", "i-3"},
+			expectedCalls: 1,
+		},
+		"one not found": {
+			errCode:       errCodeInvalidInstanceIDNotFound,
+			ids:           []string{"i-1", "i-2", "i-3"},
+			expected:      []string{"i-1", "i-3"},
+			expectedCalls: 5,
+		},
+		"several not found": {
+			errCode:       errCodeInvalidInstanceIDNotFound,
+			ids:           []string{"i-1", "i-2", "i-3", "i-4"},
+			expected:      []string{"i-1", "i-3"},
+			expectedCalls: 7,
+		},
+		"none found": {
+			errCode:       errCodeInvalidInstanceIDNotFound,
+			ids:           []string{"i-2", "i-4"},
+			expectedCalls: 3,
+		},
+		"other error": {
+			errCode:       "InvalidInstanceID.Malformed",
+			ids:           []string{"i-1", "i-2", "i-3"},
+			expectedCalls: 1,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			got, err := findByIDsOmittingNotFound(ctx, testCase.ids, errCodeInvalidInstanceIDNotFound, func(_ context.Context, ids []string) ([]string, error) {
+				calls++
+
+				if slices.ContainsFunc(ids, func(id string) bool { return !slices.Contains(existing, id) }) {
+					err := errs.APIError(testCase.errCode, "")
+
+					if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+						return nil, &retry.NotFoundError{
+							LastError: err,
+						}
+					}
+
+					return nil, err
+				}
+
+				return ids, nil
+			})
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("got error %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := calls, testCase.expectedCalls; got != want {
+				t.Errorf("got %d calls, want %d", got, want)
+			}
+		})
+	}
+}
@@ -91,6 +91,35 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newVPCEndpointServicePrivateDNSVerificationResource,
 			Name:    "VPC Endpoint Service Private DNS Verification",
 		},
+		{
+			Factory: newVPCRouteServerEndpointResource,
+			Name:    "VPC Route Server Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory: newVPCRouteServerPeerResource,
+			Name:    "VPC Route Server Peer",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory: newVPCRouteServerPropagationResource,
+			Name:    "VPC Route Server Propagation",
+		},
+		{
+			Factory: newVPCRouteServerResource,
+			Name:    "VPC Route Server",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory: newVPCRouteServerVPCAssociationResource,
+			Name:    "VPC Route Server VPC Association",
+		},
 	}
 }
 
@@ -536,6 +565,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_vpcs",
 			Name:     "VPCs",
 		},
+		{
+			Factory:  dataSourceVPNConnectionDeviceSampleConfiguration,
+			TypeName: "aws_vpn_connection_device_sample_configuration",
+			Name:     "VPN Connection Device Sample Configuration",
+		},
 		{
 			Factory:  dataSourceVPNGateway,
 			TypeName: "aws_vpn_gateway",
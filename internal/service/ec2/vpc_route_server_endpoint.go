@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_route_server_endpoint", name="VPC Route Server Endpoint")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func newVPCRouteServerEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vpcRouteServerEndpointResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type vpcRouteServerEndpointResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[vpcRouteServerEndpointResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*vpcRouteServerEndpointResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_route_server_endpoint"
+}
+
+func (r *vpcRouteServerEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"eni_address": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"eni_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"route_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSubnetID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vpcRouteServerEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vpcRouteServerEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateRouteServerEndpointInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		RouteServerId:     fwflex.StringFromFramework(ctx, data.RouteServerID),
+		SubnetId:          fwflex.StringFromFramework(ctx, data.SubnetID),
+		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypeRouteServerEndpoint),
+	}
+
+	output, err := conn.CreateRouteServerEndpoint(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating VPC Route Server Endpoint", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.RouteServerEndpoint.RouteServerEndpointId)
+	data.ID = types.StringValue(id)
+
+	endpoint, err := waitRouteServerEndpointCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server Endpoint (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = r.routeServerEndpointARN(ctx, id)
+	data.flatten(ctx, endpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteServerEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vpcRouteServerEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	endpoint, err := findRouteServerEndpointByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Route Server Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ARN = r.routeServerEndpointARN(ctx, id)
+	data.flatten(ctx, endpoint)
+
+	setTagsOut(ctx, endpoint.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteServerEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vpcRouteServerEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.ID.ValueString()
+	_, err := conn.DeleteRouteServerEndpoint(ctx, &ec2.DeleteRouteServerEndpointInput{
+		RouteServerEndpointId: aws.String(id),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerEndpointIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Route Server Endpoint (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitRouteServerEndpointDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server Endpoint (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *vpcRouteServerEndpointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *vpcRouteServerEndpointResource) routeServerEndpointARN(ctx context.Context, id string) types.String {
+	return types.StringValue(r.Meta().RegionalARN(ctx, names.EC2, "route-server-endpoint/"+id))
+}
+
+type vpcRouteServerEndpointResourceModel struct {
+	ARN           types.String   `tfsdk:"arn"`
+	ENIAddress    types.String   `tfsdk:"eni_address"`
+	ENIID         types.String   `tfsdk:"eni_id"`
+	ID            types.String   `tfsdk:"id"`
+	RouteServerID types.String   `tfsdk:"route_server_id"`
+	SubnetID      types.String   `tfsdk:"subnet_id"`
+	Tags          tftags.Map     `tfsdk:"tags"`
+	TagsAll       tftags.Map     `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	VPCID         types.String   `tfsdk:"vpc_id"`
+}
+
+func (data *vpcRouteServerEndpointResourceModel) flatten(ctx context.Context, apiObject *awstypes.RouteServerEndpoint) {
+	data.ENIAddress = fwflex.StringToFramework(ctx, apiObject.EniAddress)
+	data.ENIID = fwflex.StringToFramework(ctx, apiObject.EniId)
+	data.RouteServerID = fwflex.StringToFramework(ctx, apiObject.RouteServerId)
+	data.SubnetID = fwflex.StringToFramework(ctx, apiObject.SubnetId)
+	data.VPCID = fwflex.StringToFramework(ctx, apiObject.VpcId)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amazon_side_asn", fmt.Sprint(rASN)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "persist_routes", "disable"),
+					resource.TestCheckResourceAttr(resourceName, "sns_notifications_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "persist_routes", "disable"),
+					resource.TestCheckResourceAttr(resourceName, "sns_notifications_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccVPCRouteServerConfig_persistRoutes(rName, rASN, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "persist_routes", "enable"),
+					resource.TestCheckResourceAttr(resourceName, "persist_routes_duration", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sns_notifications_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "sns_topic_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "persist_routes", "disable"),
+					resource.TestCheckResourceAttr(resourceName, "sns_notifications_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckVPCRouteServerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCRouteServerConfig_basic(rName string, rASN int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rASN)
+}
+
+func testAccVPCRouteServerConfig_persistRoutes(rName string, rASN, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn           = %[2]d
+  persist_routes            = "enable"
+  persist_routes_duration   = %[3]d
+  sns_notifications_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rASN, duration)
+}
+
+func testAccVPCRouteServerConfig_baseAssociation(rName string, rASN int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), testAccVPCRouteServerConfig_basic(rName, rASN), `
+resource "aws_vpc_route_server_vpc_association" "test" {
+  route_server_id = aws_vpc_route_server.test.id
+  vpc_id          = aws_vpc.test.id
+}
+`)
+}
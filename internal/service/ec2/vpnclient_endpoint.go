@@ -135,6 +135,21 @@ func resourceClientVPNEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"client_route_enforcement_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforced": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"connection_log_options": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -161,6 +176,11 @@ func resourceClientVPNEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disconnect_on_session_timeout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			names.AttrDNSName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -257,6 +277,10 @@ func resourceClientVPNEndpointCreate(ctx context.Context, d *schema.ResourceData
 		input.ClientLoginBannerOptions = expandClientLoginBannerOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("client_route_enforcement_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ClientRouteEnforcementOptions = expandClientRouteEnforcementOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("connection_log_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.ConnectionLogOptions = expandConnectionLogOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -265,6 +289,10 @@ func resourceClientVPNEndpointCreate(ctx context.Context, d *schema.ResourceData
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOkExists("disconnect_on_session_timeout"); ok {
+		input.DisconnectOnSessionTimeout = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("dns_servers"); ok && len(v.([]interface{})) > 0 {
 		input.DnsServers = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -338,6 +366,13 @@ func resourceClientVPNEndpointRead(ctx context.Context, d *schema.ResourceData,
 	} else {
 		d.Set("client_login_banner_options", nil)
 	}
+	if ep.ClientRouteEnforcementOptions != nil {
+		if err := d.Set("client_route_enforcement_options", []interface{}{flattenClientRouteEnforcementResponseOptions(ep.ClientRouteEnforcementOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting client_route_enforcement_options: %s", err)
+		}
+	} else {
+		d.Set("client_route_enforcement_options", nil)
+	}
 	if ep.ConnectionLogOptions != nil {
 		if err := d.Set("connection_log_options", []interface{}{flattenConnectionLogResponseOptions(ep.ConnectionLogOptions)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting connection_log_options: %s", err)
@@ -346,6 +381,7 @@ func resourceClientVPNEndpointRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("connection_log_options", nil)
 	}
 	d.Set(names.AttrDescription, ep.Description)
+	d.Set("disconnect_on_session_timeout", ep.DisconnectOnSessionTimeout)
 	d.Set(names.AttrDNSName, ep.DnsName)
 	d.Set("dns_servers", aws.StringSlice(ep.DnsServers))
 	d.Set(names.AttrSecurityGroupIDs, aws.StringSlice(ep.SecurityGroupIds))
@@ -391,6 +427,12 @@ func resourceClientVPNEndpointUpdate(ctx context.Context, d *schema.ResourceData
 			}
 		}
 
+		if d.HasChange("client_route_enforcement_options") {
+			if v, ok := d.GetOk("client_route_enforcement_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ClientRouteEnforcementOptions = expandClientRouteEnforcementOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("connection_log_options") {
 			if v, ok := d.GetOk("connection_log_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.ConnectionLogOptions = expandConnectionLogOptions(v.([]interface{})[0].(map[string]interface{}))
@@ -401,6 +443,10 @@ func resourceClientVPNEndpointUpdate(ctx context.Context, d *schema.ResourceData
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange("disconnect_on_session_timeout") {
+			input.DisconnectOnSessionTimeout = aws.Bool(d.Get("disconnect_on_session_timeout").(bool))
+		}
+
 		if d.HasChange("dns_servers") {
 			dnsServers := d.Get("dns_servers").([]interface{})
 			enabled := len(dnsServers) > 0
@@ -671,6 +717,34 @@ func flattenClientLoginBannerResponseOptions(apiObject *awstypes.ClientLoginBann
 	return tfMap
 }
 
+func expandClientRouteEnforcementOptions(tfMap map[string]interface{}) *awstypes.ClientRouteEnforcementOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ClientRouteEnforcementOptions{}
+
+	if v, ok := tfMap["enforced"].(bool); ok {
+		apiObject.Enforced = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenClientRouteEnforcementResponseOptions(apiObject *awstypes.ClientRouteEnforcementResponseOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enforced; v != nil {
+		tfMap["enforced"] = v
+	}
+
+	return tfMap
+}
+
 func expandConnectionLogOptions(tfMap map[string]interface{}) *awstypes.ConnectionLogOptions {
 	if tfMap == nil {
 		return nil
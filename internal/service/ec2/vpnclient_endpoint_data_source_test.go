@@ -38,9 +38,11 @@ func testAccClientVPNEndpointDataSource_basic(t *testing.T, semaphore tfsync.Sem
 					resource.TestCheckResourceAttrPair(datasource1Name, "client_cidr_block", resourceName, "client_cidr_block"),
 					resource.TestCheckResourceAttrPair(datasource1Name, "client_connect_options.#", resourceName, "client_connect_options.#"),
 					resource.TestCheckResourceAttrPair(datasource1Name, "client_login_banner_options.#", resourceName, "client_login_banner_options.#"),
+					resource.TestCheckResourceAttrPair(datasource1Name, "client_route_enforcement_options.#", resourceName, "client_route_enforcement_options.#"),
 					resource.TestCheckResourceAttrPair(datasource1Name, "client_vpn_endpoint_id", resourceName, names.AttrID),
 					resource.TestCheckResourceAttrPair(datasource1Name, "connection_log_options.#", resourceName, "connection_log_options.#"),
 					resource.TestCheckResourceAttrPair(datasource1Name, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(datasource1Name, "disconnect_on_session_timeout", resourceName, "disconnect_on_session_timeout"),
 					resource.TestCheckResourceAttrPair(datasource1Name, names.AttrDNSName, resourceName, names.AttrDNSName),
 					resource.TestCheckResourceAttrPair(datasource1Name, "dns_servers.#", resourceName, "dns_servers.#"),
 					resource.TestCheckResourceAttrPair(datasource1Name, "security_group_ids.#", resourceName, "security_group_ids.#"),
@@ -59,9 +61,11 @@ func testAccClientVPNEndpointDataSource_basic(t *testing.T, semaphore tfsync.Sem
 					resource.TestCheckResourceAttrPair(datasource2Name, "client_cidr_block", resourceName, "client_cidr_block"),
 					resource.TestCheckResourceAttrPair(datasource2Name, "client_connect_options.#", resourceName, "client_connect_options.#"),
 					resource.TestCheckResourceAttrPair(datasource2Name, "client_login_banner_options.#", resourceName, "client_login_banner_options.#"),
+					resource.TestCheckResourceAttrPair(datasource2Name, "client_route_enforcement_options.#", resourceName, "client_route_enforcement_options.#"),
 					resource.TestCheckResourceAttrPair(datasource2Name, "client_vpn_endpoint_id", resourceName, names.AttrID),
 					resource.TestCheckResourceAttrPair(datasource2Name, "connection_log_options.#", resourceName, "connection_log_options.#"),
 					resource.TestCheckResourceAttrPair(datasource2Name, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(datasource2Name, "disconnect_on_session_timeout", resourceName, "disconnect_on_session_timeout"),
 					resource.TestCheckResourceAttrPair(datasource2Name, names.AttrDNSName, resourceName, names.AttrDNSName),
 					resource.TestCheckResourceAttrPair(datasource2Name, "dns_servers.#", resourceName, "dns_servers.#"),
 					resource.TestCheckResourceAttrPair(datasource2Name, "security_group_ids.#", resourceName, "security_group_ids.#"),
@@ -80,9 +84,11 @@ func testAccClientVPNEndpointDataSource_basic(t *testing.T, semaphore tfsync.Sem
 					resource.TestCheckResourceAttrPair(datasource3Name, "client_cidr_block", resourceName, "client_cidr_block"),
 					resource.TestCheckResourceAttrPair(datasource3Name, "client_connect_options.#", resourceName, "client_connect_options.#"),
 					resource.TestCheckResourceAttrPair(datasource3Name, "client_login_banner_options.#", resourceName, "client_login_banner_options.#"),
+					resource.TestCheckResourceAttrPair(datasource3Name, "client_route_enforcement_options.#", resourceName, "client_route_enforcement_options.#"),
 					resource.TestCheckResourceAttrPair(datasource3Name, "client_vpn_endpoint_id", resourceName, names.AttrID),
 					resource.TestCheckResourceAttrPair(datasource3Name, "connection_log_options.#", resourceName, "connection_log_options.#"),
 					resource.TestCheckResourceAttrPair(datasource3Name, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(datasource3Name, "disconnect_on_session_timeout", resourceName, "disconnect_on_session_timeout"),
 					resource.TestCheckResourceAttrPair(datasource3Name, names.AttrDNSName, resourceName, names.AttrDNSName),
 					resource.TestCheckResourceAttrPair(datasource3Name, "dns_servers.#", resourceName, "dns_servers.#"),
 					resource.TestCheckResourceAttrPair(datasource3Name, "security_group_ids.#", resourceName, "security_group_ids.#"),
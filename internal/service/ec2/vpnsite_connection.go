@@ -44,7 +44,11 @@ func resourceVPNConnection() *schema.Resource {
 		DeleteWithoutTimeout: resourceVPNConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_tunnel_replacement", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,6 +130,11 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"skip_tunnel_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"static_routes_only": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -218,6 +227,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel1_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -251,6 +264,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel1_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel1_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -434,6 +455,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel2_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -467,6 +492,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel2_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel2_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -847,6 +880,21 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel2_vgw_inside_address", nil)
 	}
 
+	// Pending maintenance is only of interest when the tunnel endpoint lifecycle is customer controlled.
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		if address := d.Get(prefix + names.AttrAddress).(string); address != "" && d.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			output, err := findVPNTunnelReplacementStatusByTwoPartKey(ctx, conn, d.Id(), address)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) tunnel (%s) replacement status: %s", d.Id(), address, err)
+			}
+
+			flattenMaintenanceDetails(d, prefix, output.MaintenanceDetails)
+		} else {
+			flattenMaintenanceDetails(d, prefix, nil)
+		}
+	}
+
 	return diags
 }
 
@@ -922,6 +970,10 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 				VpnTunnelOutsideIpAddress: aws.String(address),
 			}
 
+			if d.Get("skip_tunnel_replacement").(bool) {
+				input.SkipTunnelReplacement = aws.Bool(true)
+			}
+
 			_, err := conn.ModifyVpnTunnelOptions(ctx, input)
 
 			if err != nil {
@@ -1429,6 +1481,28 @@ func flattenTunnelOption(d *schema.ResourceData, prefix string, apiObject awstyp
 	return nil
 }
 
+func flattenMaintenanceDetails(d *schema.ResourceData, prefix string, apiObject *awstypes.MaintenanceDetails) {
+	if apiObject == nil {
+		d.Set(prefix+"last_maintenance_applied", nil)
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+		d.Set(prefix+"pending_maintenance", nil)
+
+		return
+	}
+
+	if v := apiObject.LastMaintenanceApplied; v != nil {
+		d.Set(prefix+"last_maintenance_applied", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"last_maintenance_applied", nil)
+	}
+	if v := apiObject.MaintenanceAutoAppliedAfter; v != nil {
+		d.Set(prefix+"maintenance_auto_applied_after", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+	}
+	d.Set(prefix+"pending_maintenance", apiObject.PendingMaintenance)
+}
+
 func flattenVPNStaticRoute(apiObject awstypes.VpnStaticRoute) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
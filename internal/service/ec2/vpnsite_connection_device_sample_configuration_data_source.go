@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_vpn_connection_device_sample_configuration", name="VPN Connection Device Sample Configuration")
+func dataSourceVPNConnectionDeviceSampleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPNConnectionDeviceSampleConfigurationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"internet_key_exchange_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vpnTunnelOptionsIKEVersion_Values(), false),
+			},
+			"platform": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"software": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"vendor": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"vpn_connection_device_sample_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"vpn_connection_device_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"vendor", "vpn_connection_device_type_id"},
+			},
+			"vpn_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPNConnectionDeviceSampleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	deviceTypeID := d.Get("vpn_connection_device_type_id").(string)
+
+	if deviceTypeID == "" {
+		deviceTypes, err := findVPNConnectionDeviceTypes(ctx, conn, &ec2.GetVpnConnectionDeviceTypesInput{})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection Device Types: %s", err)
+		}
+
+		vendor, platform, software := d.Get("vendor").(string), d.Get("platform").(string), d.Get("software").(string)
+		var matches []awstypes.VpnConnectionDeviceType
+
+		for _, v := range deviceTypes {
+			if aws.ToString(v.Vendor) != vendor {
+				continue
+			}
+			if platform != "" && aws.ToString(v.Platform) != platform {
+				continue
+			}
+			if software != "" && aws.ToString(v.Software) != software {
+				continue
+			}
+
+			matches = append(matches, v)
+		}
+
+		deviceType, err := tfresource.AssertSingleValueResult(matches)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 VPN Connection Device Type", err))
+		}
+
+		deviceTypeID = aws.ToString(deviceType.VpnConnectionDeviceTypeId)
+		d.Set("platform", deviceType.Platform)
+		d.Set("software", deviceType.Software)
+		d.Set("vendor", deviceType.Vendor)
+	}
+
+	vpnConnectionID := d.Get("vpn_connection_id").(string)
+	input := &ec2.GetVpnConnectionDeviceSampleConfigurationInput{
+		VpnConnectionDeviceTypeId: aws.String(deviceTypeID),
+		VpnConnectionId:           aws.String(vpnConnectionID),
+	}
+
+	if v, ok := d.GetOk("internet_key_exchange_version"); ok {
+		input.InternetKeyExchangeVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.GetVpnConnectionDeviceSampleConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) Device (%s) Sample Configuration: %s", vpnConnectionID, deviceTypeID, err)
+	}
+
+	d.SetId(vpnConnectionID + "," + deviceTypeID)
+	d.Set("vpn_connection_device_sample_configuration", output.VpnConnectionDeviceSampleConfiguration)
+	d.Set("vpn_connection_device_type_id", deviceTypeID)
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSiteVPNConnectionDeviceSampleConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpn_connection_device_sample_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "platform", "ASA 5500 Series"),
+					resource.TestCheckResourceAttr(dataSourceName, "software", "ASA 9.7+ VTI"),
+					resource.TestCheckResourceAttr(dataSourceName, "vendor", "Cisco Systems, Inc."),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_sample_configuration"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_type_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", "aws_vpn_connection.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_basic(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(testAccSiteVPNConnectionConfig_basic(rName, rBgpAsn), `
+data "aws_vpn_connection_device_sample_configuration" "test" {
+  vpn_connection_id = aws_vpn_connection.test.id
+  vendor            = "Cisco Systems, Inc."
+  platform          = "ASA 5500 Series"
+  software          = "ASA 9.7+ VTI"
+
+  internet_key_exchange_version = "ikev2"
+}
+`)
+}
@@ -137,6 +137,11 @@ func resourceFileSystem() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							// A file system that is the destination of a replication configuration reports REPLICATING.
+							// Overwrite protection must have been DISABLED for the replication to be created, so don't show a diff.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old == string(awstypes.ReplicationOverwriteProtectionReplicating) && new == string(awstypes.ReplicationOverwriteProtectionDisabled)
+							},
 							ValidateFunc: validation.StringInSlice(enum.Slice(
 								awstypes.ReplicationOverwriteProtectionEnabled,
 								awstypes.ReplicationOverwriteProtectionDisabled,
@@ -400,8 +405,8 @@ func resourceFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
-func findFileSystem(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput, filter tfslices.Predicate[*awstypes.FileSystemDescription]) (*awstypes.FileSystemDescription, error) {
-	output, err := findFileSystems(ctx, conn, input, filter)
+func findFileSystem(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput, filter tfslices.Predicate[*awstypes.FileSystemDescription], optFns ...func(*efs.Options)) (*awstypes.FileSystemDescription, error) {
+	output, err := findFileSystems(ctx, conn, input, filter, optFns...)
 
 	if err != nil {
 		return nil, err
@@ -410,12 +415,12 @@ func findFileSystem(ctx context.Context, conn *efs.Client, input *efs.DescribeFi
 	return tfresource.AssertSingleValueResult(output)
 }
 
-func findFileSystems(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput, filter tfslices.Predicate[*awstypes.FileSystemDescription]) ([]awstypes.FileSystemDescription, error) {
+func findFileSystems(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput, filter tfslices.Predicate[*awstypes.FileSystemDescription], optFns ...func(*efs.Options)) ([]awstypes.FileSystemDescription, error) {
 	var output []awstypes.FileSystemDescription
 
 	pages := efs.NewDescribeFileSystemsPaginator(conn, input)
 	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+		page, err := pages.NextPage(ctx, optFns...)
 
 		if errs.IsA[*awstypes.FileSystemNotFound](err) {
 			return nil, &retry.NotFoundError{
@@ -438,12 +443,12 @@ func findFileSystems(ctx context.Context, conn *efs.Client, input *efs.DescribeF
 	return output, nil
 }
 
-func findFileSystemByID(ctx context.Context, conn *efs.Client, id string) (*awstypes.FileSystemDescription, error) {
+func findFileSystemByID(ctx context.Context, conn *efs.Client, id string, optFns ...func(*efs.Options)) (*awstypes.FileSystemDescription, error) {
 	input := &efs.DescribeFileSystemsInput{
 		FileSystemId: aws.String(id),
 	}
 
-	output, err := findFileSystem(ctx, conn, input, tfslices.PredicateTrue[*awstypes.FileSystemDescription]())
+	output, err := findFileSystem(ctx, conn, input, tfslices.PredicateTrue[*awstypes.FileSystemDescription](), optFns...)
 
 	if err != nil {
 		return nil, err
@@ -194,6 +194,14 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// The destination file system remains read-only until its replication overwrite protection leaves the REPLICATING state.
+	// Wait for it to become writeable so that it can immediately be used as a failback source.
+	if fsID := aws.ToString(destination.FileSystemId); fsID != "" {
+		if _, err := waitFileSystemReplicationOverwriteProtectionReleased(ctx, conn, fsID, d.Timeout(schema.TimeoutDelete), optFn); err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "waiting for EFS File System (%s) replication overwrite protection update: %s", fsID, err)
+		}
+	}
+
 	return diags
 }
 
@@ -324,6 +332,44 @@ func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.Client,
 	return nil, err
 }
 
+func statusFileSystemReplicationOverwriteProtection(ctx context.Context, conn *efs.Client, id string, optFns ...func(*efs.Options)) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFileSystemByID(ctx, conn, id, optFns...)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// File systems that predate replication overwrite protection are always writeable.
+		if output.FileSystemProtection == nil {
+			return output, string(awstypes.ReplicationOverwriteProtectionEnabled), nil
+		}
+
+		return output, string(output.FileSystemProtection.ReplicationOverwriteProtection), nil
+	}
+}
+
+func waitFileSystemReplicationOverwriteProtectionReleased(ctx context.Context, conn *efs.Client, id string, timeout time.Duration, optFns ...func(*efs.Options)) (*awstypes.FileSystemDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ReplicationOverwriteProtectionReplicating),
+		Target:  enum.Slice(awstypes.ReplicationOverwriteProtectionEnabled, awstypes.ReplicationOverwriteProtectionDisabled),
+		Refresh: statusFileSystemReplicationOverwriteProtection(ctx, conn, id, optFns...),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FileSystemDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandDestinationToCreate(tfMap map[string]interface{}) *awstypes.DestinationToCreate {
 	apiObject := &awstypes.DestinationToCreate{}
 
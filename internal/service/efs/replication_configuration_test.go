@@ -163,6 +163,54 @@ func TestAccEFSReplicationConfiguration_existingDestination(t *testing.T) {
 	})
 }
 
+func TestAccEFSReplicationConfiguration_failback(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_efs_replication_configuration.test"
+	failbackResourceName := "aws_efs_replication_configuration.failback"
+	sourceFsResourceName := "aws_efs_file_system.source"
+	destinationFsResourceName := "aws_efs_file_system.destination"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             acctest.CheckWithProviders(testAccCheckReplicationConfigurationDestroyWithProvider(ctx), &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_existingDestination(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.status", string(awstypes.ReplicationStatusEnabled)),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationConfig_failbackPrepare(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(destinationFsResourceName, "protection.0.replication_overwrite", string(awstypes.ReplicationOverwriteProtectionEnabled)),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationConfig_failback(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(failbackResourceName, "source_file_system_id", destinationFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(failbackResourceName, "destination.0.file_system_id", sourceFsResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(failbackResourceName, "destination.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(failbackResourceName, "destination.0.status", string(awstypes.ReplicationStatusEnabled)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -300,3 +348,52 @@ resource "aws_efs_replication_configuration" "test" {
 }
 `, rName, acctest.AlternateRegion()))
 }
+
+func testAccReplicationConfigurationConfig_failbackBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_efs_file_system" "source" {
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_file_system" "destination" {
+  provider = "awsalternate"
+
+  protection {
+    replication_overwrite = "DISABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [protection]
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationConfig_failbackPrepare(rName string) string {
+	return testAccReplicationConfigurationConfig_failbackBase(rName)
+}
+
+func testAccReplicationConfigurationConfig_failback(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationConfigurationConfig_failbackBase(rName), fmt.Sprintf(`
+resource "aws_efs_replication_configuration" "failback" {
+  provider = "awsalternate"
+
+  source_file_system_id = aws_efs_file_system.destination.id
+
+  destination {
+    file_system_id = aws_efs_file_system.source.id
+    region         = %[1]q
+  }
+}
+`, acctest.Region()))
+}
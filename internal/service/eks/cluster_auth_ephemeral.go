@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_eks_cluster_auth", name="Cluster Auth")
+func newClusterAuthEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &clusterAuthEphemeralResource{}, nil
+}
+
+type clusterAuthEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*clusterAuthEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_cluster_auth"
+}
+
+func (e *clusterAuthEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cluster_id"), path.MatchRoot(names.AttrName)),
+				},
+			},
+			names.AttrDuration: schema.StringAttribute{
+				CustomType: fwtypes.DurationType,
+				Optional:   true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *clusterAuthEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data clusterAuthEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	// The cluster identifier is sent in the x-k8s-aws-id header. For EKS clusters it is the cluster name.
+	clusterID := data.Name.ValueString()
+	if !data.ClusterID.IsNull() {
+		clusterID = data.ClusterID.ValueString()
+	}
+
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		response.Diagnostics.AddError("creating EKS Cluster Authentication Token generator", err.Error())
+
+		return
+	}
+
+	var token Token
+	if data.Duration.IsNull() {
+		token, err = generator.GetWithSTS(ctx, clusterID, conn)
+	} else {
+		token, err = generator.GetWithSTSAndDuration(ctx, clusterID, conn, data.Duration.ValueDuration())
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s) Authentication Token", clusterID), err.Error())
+
+		return
+	}
+
+	data.Expiration = fwflex.TimeToFramework(ctx, &token.Expiration)
+	data.Token = types.StringValue(token.Token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type clusterAuthEphemeralResourceModel struct {
+	ClusterID  types.String      `tfsdk:"cluster_id"`
+	Duration   fwtypes.Duration  `tfsdk:"duration"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Name       types.String      `tfsdk:"name"`
+	Token      types.String      `tfsdk:"token"`
+}
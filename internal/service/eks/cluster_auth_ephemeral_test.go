@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterAuthEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthEphemeralConfig_basic,
+			},
+			{
+				Config: testAccClusterAuthEphemeralConfig_clusterIDDuration("5m"),
+			},
+			{
+				Config:      testAccClusterAuthEphemeralConfig_clusterIDDuration("30m"),
+				ExpectError: regexache.MustCompile(`token duration \(30m0s\) must be greater than 0 and at most 15m0s`),
+			},
+		},
+	})
+}
+
+const testAccClusterAuthEphemeralConfig_basic = `
+ephemeral "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+`
+
+func testAccClusterAuthEphemeralConfig_clusterIDDuration(duration string) string {
+	return fmt.Sprintf(`
+ephemeral "aws_eks_cluster_auth" "test" {
+  cluster_id = "00000000-0000-0000-0000-000000000000"
+  duration   = %[1]q
+}
+`, duration)
+}
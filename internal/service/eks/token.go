@@ -13,6 +13,7 @@ With the following modifications:
  - Refactor deprecated io/ioutil in Go 1.16
  - Adds context parameter
  - Updated to use the AWS SDK for Go v2
+ - Adds GetWithSTSAndDuration, for tokens that expire before the presigned URL
 */
 
 /*
@@ -104,7 +105,8 @@ const (
 
 // Token is generated and used by Kubernetes client-go to authenticate with a Kubernetes cluster.
 type Token struct {
-	Token string
+	Token      string
+	Expiration time.Time
 }
 
 // FormatError is returned when there is a problem with token that is
@@ -163,6 +165,9 @@ type getCallerIdentityWrapper struct {
 type Generator interface {
 	// GetWithSTS returns a token valid for clusterID using the given STS client.
 	GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts.Client) (Token, error)
+	// GetWithSTSAndDuration returns a token valid for clusterID using the given STS client,
+	// whose presigned URL expires after the given duration.
+	GetWithSTSAndDuration(ctx context.Context, clusterID string, stsAPI *sts.Client, duration time.Duration) (Token, error)
 }
 
 type generator struct {
@@ -184,12 +189,42 @@ func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts
 	// not supported by the STS presigner.  We set it to 60 [nano]seconds for backwards compatibility (the
 	// parameter was a required argument to AWS SDK v1's Presign(), and authenticators 0.3.0 and older are
 	// expecting a value between 0 and 60 on the server side).
+	token, err := g.getWithSTS(ctx, clusterID, stsAPI, requestPresignParam)
+	if err != nil {
+		return Token{}, err
+	}
+
+	// Set token expiration to 1 minute before the presigned URL expires for some cushion
+	token.Expiration = time.Now().Local().Add(presignedURLExpiration - 1*time.Minute)
+
+	return token, nil
+}
+
+// GetWithSTSAndDuration returns a token valid for clusterID using the given STS client,
+// whose presigned URL expires after the given duration.
+// The duration must not be longer than the 15 minute validity of the presigned URL.
+func (g generator) GetWithSTSAndDuration(ctx context.Context, clusterID string, stsAPI *sts.Client, duration time.Duration) (Token, error) {
+	if duration <= 0 || duration > presignedURLExpiration {
+		return Token{}, fmt.Errorf("token duration (%s) must be greater than 0 and at most %s", duration, presignedURLExpiration)
+	}
+
+	token, err := g.getWithSTS(ctx, clusterID, stsAPI, duration)
+	if err != nil {
+		return Token{}, err
+	}
+
+	token.Expiration = time.Now().Local().Add(duration)
+
+	return token, nil
+}
+
+func (g generator) getWithSTS(ctx context.Context, clusterID string, stsAPI *sts.Client, expire time.Duration) (Token, error) {
 	presigner := sts.NewPresignClient(stsAPI, func(po *sts.PresignOptions) {
 		po.ClientOptions = []func(*sts.Options){
 			func(o *sts.Options) {
 				o.APIOptions = []func(*middleware.Stack) error{
 					addClusterIdHeaderSetterMiddleware(clusterID),
-					addExpiryParamSetterMiddleware(requestPresignParam),
+					addExpiryParamSetterMiddleware(expire),
 				}
 			},
 		}
@@ -200,7 +235,7 @@ func (g generator) GetWithSTS(ctx context.Context, clusterID string, stsAPI *sts
 		return Token{}, err
 	}
 
-	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))}, nil
+	return Token{Token: v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(request.URL))}, nil
 }
 
 func addClusterIdHeaderSetterMiddleware(clusterID string) func(*middleware.Stack) error {
@@ -10,6 +10,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"fmt"
 	"log"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -77,6 +79,23 @@ const (
 	environmentTierTypeStandard = "Standard"
 )
 
+const (
+	optionNamespaceManagedActions               = "aws:elasticbeanstalk:managedactions"
+	optionNamespaceManagedActionsPlatformUpdate = "aws:elasticbeanstalk:managedactions:platformupdate"
+)
+
+const (
+	managedActionsUpdateLevelMinor = "minor"
+	managedActionsUpdateLevelPatch = "patch"
+)
+
+func managedActionsUpdateLevel_Values() []string {
+	return []string{
+		managedActionsUpdateLevelMinor,
+		managedActionsUpdateLevelPatch,
+	}
+}
+
 var (
 	environmentCNAMERegex = regexache.MustCompile(`(^[^.]+)(.\w{2}-\w{4,9}-\d)?\.(elasticbeanstalk\.com|eb\.amazonaws\.com\.cn)$`)
 )
@@ -95,7 +114,13 @@ func resourceEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// An environment cannot be updated in place to a platform of a different family (e.g. Python to Docker).
+			customdiff.ForceNewIfChange("platform_arn", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) != "" && platformFamilyFromARN(old.(string)) != platformFamilyFromARN(new.(string))
+			}),
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -154,6 +179,41 @@ func resourceEnvironment() *schema.Resource {
 					Computed: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
+				"managed_actions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrEnabled: {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"instance_refresh_enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Computed: true,
+							},
+							"preferred_start_time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(Mon|Tue|Wed|Thu|Fri|Sat|Sun):([01]\d|2[0-3]):[0-5]\d$`), "must be in the format day:hour:minute, e.g. Sun:10:00"),
+							},
+							"service_role_for_managed_updates": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"update_level": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(managedActionsUpdateLevel_Values(), false),
+							},
+						},
+					},
+				},
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -245,6 +305,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.OptionSettings = expandConfigurationOptionSettings(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("managed_actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OptionSettings = append(input.OptionSettings, expandManagedActions(v.([]interface{})[0].(map[string]interface{}))...)
+	}
+
 	if v := d.Get("solution_stack_name"); v.(string) != "" {
 		input.SolutionStackName = aws.String(v.(string))
 	}
@@ -378,6 +442,13 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set("load_balancers", flattenLoadBalancers(resources.EnvironmentResources.LoadBalancers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting load_balancers: %s", err)
 	}
+	if _, ok := d.GetOk("managed_actions"); ok {
+		if err := d.Set("managed_actions", []interface{}{flattenManagedActions(configurationSettings.OptionSettings)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting managed_actions: %s", err)
+		}
+	} else {
+		d.Set("managed_actions", nil)
+	}
 	d.Set(names.AttrName, environmentName)
 	d.Set("platform_arn", env.PlatformArn)
 	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
@@ -514,6 +585,14 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.OptionSettings = add
 		}
 
+		if d.HasChange("managed_actions") {
+			if v, ok := d.GetOk("managed_actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OptionSettings = append(input.OptionSettings, expandManagedActions(v.([]interface{})[0].(map[string]interface{}))...)
+			} else {
+				input.OptionsToRemove = append(input.OptionsToRemove, managedActionsOptionSpecifications()...)
+			}
+		}
+
 		if d.HasChange("solution_stack_name") {
 			if v, ok := d.GetOk("solution_stack_name"); ok {
 				input.SolutionStackName = aws.String(v.(string))
@@ -541,6 +620,13 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) update: %s", d.Id(), err)
 	}
 
+	// A platform update leaves the environment's health unknown until the new instances report in.
+	if d.HasChanges("platform_arn", "solution_stack_name") {
+		if _, err := waitEnvironmentHealthy(ctx, conn, d.Id(), pollInterval, waitForReadyTimeOut); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health after platform update: %s", d.Id(), err)
+		}
+	}
+
 	err = findEnvironmentErrorsByID(ctx, conn, d.Id(), opTime)
 
 	if err != nil {
@@ -717,6 +803,22 @@ func statusEnvironment(ctx context.Context, conn *elasticbeanstalk.Client, id st
 	}
 }
 
+func statusEnvironmentHealth(ctx context.Context, conn *elasticbeanstalk.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Health), nil
+	}
+}
+
 func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.EnvironmentStatusLaunching, awstypes.EnvironmentStatusUpdating),
@@ -737,6 +839,26 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id
 	return nil, err
 }
 
+func waitEnvironmentHealthy(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.EnvironmentHealthGrey),
+		Target:       enum.Slice(awstypes.EnvironmentHealthGreen, awstypes.EnvironmentHealthYellow),
+		Refresh:      statusEnvironmentHealth(ctx, conn, id),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: pollInterval,
+		MinTimeout:   3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.EnvironmentStatusTerminating),
@@ -848,6 +970,96 @@ func flattenConfigurationOptionSettings(ctx context.Context, meta interface{}, a
 	return tfList
 }
 
+// platformFamilyFromARN returns the platform family (e.g. "Python") from a platform ARN such as
+// arn:aws:elasticbeanstalk:us-east-1::platform/Python 3.11 running on 64bit Amazon Linux 2023/4.1.3.
+func platformFamilyFromARN(s string) string {
+	_, name, _ := strings.Cut(s, ":platform/")
+	family, _, _ := strings.Cut(name, " ")
+
+	return family
+}
+
+func expandManagedActions(tfMap map[string]interface{}) []awstypes.ConfigurationOptionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObjects := []awstypes.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionNamespaceManagedActions),
+			OptionName: aws.String("ManagedActionsEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap[names.AttrEnabled].(bool))),
+		},
+	}
+
+	if v, ok := tfMap["instance_refresh_enabled"].(bool); ok {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("InstanceRefreshEnabled"),
+			Value:      aws.String(strconv.FormatBool(v)),
+		})
+	}
+
+	if v, ok := tfMap["preferred_start_time"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActions),
+			OptionName: aws.String("PreferredStartTime"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["service_role_for_managed_updates"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActions),
+			OptionName: aws.String("ServiceRoleForManagedUpdates"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["update_level"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("UpdateLevel"),
+			Value:      aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func managedActionsOptionSpecifications() []awstypes.OptionSpecification {
+	return []awstypes.OptionSpecification{
+		{Namespace: aws.String(optionNamespaceManagedActions), OptionName: aws.String("ManagedActionsEnabled")},
+		{Namespace: aws.String(optionNamespaceManagedActions), OptionName: aws.String("PreferredStartTime")},
+		{Namespace: aws.String(optionNamespaceManagedActions), OptionName: aws.String("ServiceRoleForManagedUpdates")},
+		{Namespace: aws.String(optionNamespaceManagedActionsPlatformUpdate), OptionName: aws.String("InstanceRefreshEnabled")},
+		{Namespace: aws.String(optionNamespaceManagedActionsPlatformUpdate), OptionName: aws.String("UpdateLevel")},
+	}
+}
+
+func flattenManagedActions(apiObjects []awstypes.ConfigurationOptionSetting) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		value := aws.ToString(apiObject.Value)
+
+		switch namespace, name := aws.ToString(apiObject.Namespace), aws.ToString(apiObject.OptionName); {
+		case namespace == optionNamespaceManagedActions && name == "ManagedActionsEnabled":
+			tfMap[names.AttrEnabled], _ = strconv.ParseBool(value)
+		case namespace == optionNamespaceManagedActions && name == "PreferredStartTime":
+			tfMap["preferred_start_time"] = value
+		case namespace == optionNamespaceManagedActions && name == "ServiceRoleForManagedUpdates":
+			tfMap["service_role_for_managed_updates"] = value
+		case namespace == optionNamespaceManagedActionsPlatformUpdate && name == "InstanceRefreshEnabled":
+			tfMap["instance_refresh_enabled"], _ = strconv.ParseBool(value)
+		case namespace == optionNamespaceManagedActionsPlatformUpdate && name == "UpdateLevel":
+			tfMap["update_level"] = value
+		}
+	}
+
+	return tfMap
+}
+
 func dropGeneratedSecurityGroup(ctx context.Context, conn *ec2.Client, settingValue string) string {
 	input := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: strings.Split(settingValue, ","),
@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -126,6 +127,41 @@ func TestAccElasticBeanstalkEnvironment_tier(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_tierChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app1, app2 awstypes.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app1),
+					resource.TestCheckResourceAttr(resourceName, "tier", "WebServer"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_worker(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app2),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Worker"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticBeanstalkEnvironment_cnamePrefix(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app awstypes.EnvironmentDescription
@@ -404,6 +440,60 @@ func TestAccElasticBeanstalkEnvironment_label(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_managedActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Sun:10:00", "minor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.instance_refresh_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Sun:10:00"),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_actions.0.service_role_for_managed_updates"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "minor"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"managed_actions",
+					"setting",
+					"wait_for_ready_timeout",
+				},
+			},
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Wed:03:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Wed:03:30"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "patch"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticBeanstalkEnvironment_settingWithJSONValue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app awstypes.EnvironmentDescription
@@ -986,6 +1076,66 @@ resource "aws_elastic_beanstalk_environment" "test" {
 `, rName))
 }
 
+func testAccEnvironmentConfig_managedActions(rName, preferredStartTime, updateLevel string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  # Managed platform updates require enhanced health reporting.
+  setting {
+    namespace = "aws:elasticbeanstalk:healthreporting:system"
+    name      = "SystemType"
+    value     = "enhanced"
+  }
+
+  managed_actions {
+    enabled                          = true
+    preferred_start_time             = %[2]q
+    service_role_for_managed_updates = aws_iam_role.service_role.name
+    update_level                     = %[3]q
+  }
+}
+`, rName, preferredStartTime, updateLevel))
+}
+
 func testAccEnvironmentConfig_cnamePrefix(rName string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_elastic_beanstalk_environment" "test" {
@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_alb_listener_certificate", name="Listener Certificate")
 // @SDKResource("aws_lb_listener_certificate", name="Listener Certificate")
+// @MovedFrom("aws_alb_listener_certificate")
 func resourceListenerCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerCertificateCreate,
@@ -149,6 +149,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb",
+				},
+			},
 		},
 		{
 			Factory:  resourceListener,
@@ -157,11 +162,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb_listener",
+				},
+			},
 		},
 		{
 			Factory:  resourceListenerCertificate,
 			TypeName: "aws_lb_listener_certificate",
 			Name:     "Listener Certificate",
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb_listener_certificate",
+				},
+			},
 		},
 		{
 			Factory:  resourceListenerRule,
@@ -170,6 +185,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb_listener_rule",
+				},
+			},
 		},
 		{
 			Factory:  resourceTargetGroup,
@@ -178,11 +198,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb_target_group",
+				},
+			},
 		},
 		{
 			Factory:  resourceTargetGroupAttachment,
 			TypeName: "aws_lb_target_group_attachment",
 			Name:     "Target Group Attachment",
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName: "aws_alb_target_group_attachment",
+				},
+			},
 		},
 		{
 			Factory:  resourceTrustStore,
@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_alb_target_group_attachment", name="Target Group Attachment")
 // @SDKResource("aws_lb_target_group_attachment", name="Target Group Attachment")
+// @MovedFrom("aws_alb_target_group_attachment")
 func resourceTargetGroupAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAttachmentCreate,
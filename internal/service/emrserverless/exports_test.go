@@ -5,7 +5,9 @@ package emrserverless
 
 // Exports for use in tests only.
 var (
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID    = findApplicationByID
+	FindJobRunByTwoPartKey = findJobRunByTwoPartKey
 
 	ResourceApplication = resourceApplication
+	ResourceJobRun      = resourceJobRun
 )
@@ -0,0 +1,654 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_emrserverless_job_run", name="Job Run")
+// @Tags(identifierAttribute="arn")
+func resourceJobRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobRunCreate,
+		ReadWithoutTimeout:   resourceJobRunRead,
+		UpdateWithoutTimeout: resourceJobRunUpdate,
+		DeleteWithoutTimeout: resourceJobRunDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_completion", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrApplicationID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logging_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrEnabled: {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												names.AttrLogGroupName: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"log_stream_name_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"managed_persistence_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrEnabled: {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+													Default:  true,
+												},
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"s3_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"log_uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrExecutionRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"execution_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 1000000),
+			},
+			"job_driver": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hive": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"job_driver.0.hive", "job_driver.0.spark_submit"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"init_query_file": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrParameters: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spark_submit": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"job_driver.0.hive", "job_driver.0.spark_submit"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entry_point": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"entry_point_arguments": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"spark_submit_parameters": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceJobRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	applicationID := d.Get(names.AttrApplicationID).(string)
+	input := &emrserverless.StartJobRunInput{
+		ApplicationId:    aws.String(applicationID),
+		ClientToken:      aws.String(id.UniqueId()),
+		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("configuration_overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConfigurationOverrides = expandConfigurationOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("execution_timeout_minutes"); ok {
+		input.ExecutionTimeoutMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("job_driver"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobDriver = expandJobDriver(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.StartJobRun(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting EMR Serverless Job Run (%s): %s", applicationID, err)
+	}
+
+	jobRunID := aws.ToString(output.JobRunId)
+	id, err := flex.FlattenResourceId([]string{applicationID, jobRunID}, jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitJobRunSucceeded(ctx, conn, applicationID, jobRunID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Serverless Job Run (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRunRead(ctx, d, meta)...)
+}
+
+func resourceJobRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, jobRunID := parts[0], parts[1]
+	jobRun, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Serverless Job Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrApplicationID, jobRun.ApplicationId)
+	d.Set(names.AttrARN, jobRun.Arn)
+	d.Set(names.AttrExecutionRoleARN, jobRun.ExecutionRole)
+	d.Set("execution_timeout_minutes", aws.ToInt64(jobRun.ExecutionTimeoutMinutes))
+	if err := d.Set("job_driver", flattenJobDriver(jobRun.JobDriver)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting job_driver: %s", err)
+	}
+	d.Set("job_run_id", jobRun.JobRunId)
+	d.Set(names.AttrName, jobRun.Name)
+	d.Set("release_label", jobRun.ReleaseLabel)
+	d.Set(names.AttrState, jobRun.State)
+	d.Set("state_details", jobRun.StateDetails)
+
+	setTagsOut(ctx, jobRun.Tags)
+
+	return diags
+}
+
+func resourceJobRunUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceJobRunRead(ctx, d, meta)
+}
+
+func resourceJobRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, jobRunID := parts[0], parts[1]
+	jobRun, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	// Job runs that have already finished can't be cancelled and are removed from state only.
+	switch jobRun.State {
+	case types.JobRunStateSuccess, types.JobRunStateFailed:
+		return diags
+	}
+
+	log.Printf("[INFO] Cancelling EMR Serverless Job Run: %s", d.Id())
+	_, err = conn.CancelJobRun(ctx, &emrserverless.CancelJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobRunCancelled(ctx, conn, applicationID, jobRunID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EMR Serverless Job Run (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	jobRunResourceIDPartCount = 2
+)
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string) (*types.JobRun, error) {
+	input := &emrserverless.GetJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	}
+
+	output, err := conn.GetJobRun(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.JobRun.State; state == types.JobRunStateCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.JobRun, nil
+}
+
+func statusJobRun(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitJobRunSucceeded(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string, timeout time.Duration) (*types.JobRun, error) {
+	const (
+		minTimeout = 10 * time.Second
+		delay      = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.JobRunStateSubmitted, types.JobRunStatePending, types.JobRunStateScheduled, types.JobRunStateRunning),
+		Target:     enum.Slice(types.JobRunStateSuccess),
+		Refresh:    statusJobRun(ctx, conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobRun); ok {
+		if stateDetails := output.StateDetails; stateDetails != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateDetails)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobRunCancelled(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string, timeout time.Duration) (*types.JobRun, error) {
+	const (
+		minTimeout = 10 * time.Second
+		delay      = 10 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.JobRunStateSubmitted, types.JobRunStatePending, types.JobRunStateScheduled, types.JobRunStateRunning, types.JobRunStateCancelling),
+		Target:     []string{},
+		Refresh:    statusJobRun(ctx, conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobRun); ok {
+		if stateDetails := output.StateDetails; stateDetails != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateDetails)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandJobDriver(tfMap map[string]interface{}) types.JobDriver {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["hive"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := types.Hive{}
+
+		if v, ok := tfMap["init_query_file"].(string); ok && v != "" {
+			apiObject.InitQueryFile = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrParameters].(string); ok && v != "" {
+			apiObject.Parameters = aws.String(v)
+		}
+
+		if v, ok := tfMap["query"].(string); ok && v != "" {
+			apiObject.Query = aws.String(v)
+		}
+
+		return &types.JobDriverMemberHive{Value: apiObject}
+	}
+
+	if v, ok := tfMap["spark_submit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := types.SparkSubmit{}
+
+		if v, ok := tfMap["entry_point"].(string); ok && v != "" {
+			apiObject.EntryPoint = aws.String(v)
+		}
+
+		if v, ok := tfMap["entry_point_arguments"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPointArguments = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["spark_submit_parameters"].(string); ok && v != "" {
+			apiObject.SparkSubmitParameters = aws.String(v)
+		}
+
+		return &types.JobDriverMemberSparkSubmit{Value: apiObject}
+	}
+
+	return nil
+}
+
+func flattenJobDriver(apiObject types.JobDriver) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.JobDriverMemberHive:
+		tfMap["hive"] = []interface{}{map[string]interface{}{
+			"init_query_file":    aws.ToString(v.Value.InitQueryFile),
+			names.AttrParameters: aws.ToString(v.Value.Parameters),
+			"query":              aws.ToString(v.Value.Query),
+		}}
+	case *types.JobDriverMemberSparkSubmit:
+		tfMap["spark_submit"] = []interface{}{map[string]interface{}{
+			"entry_point":             aws.ToString(v.Value.EntryPoint),
+			"entry_point_arguments":   flex.FlattenStringValueList(v.Value.EntryPointArguments),
+			"spark_submit_parameters": aws.ToString(v.Value.SparkSubmitParameters),
+		}}
+	default:
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandConfigurationOverrides(tfMap map[string]interface{}) *types.ConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ConfigurationOverrides{}
+
+	if v, ok := tfMap["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MonitoringConfiguration = expandMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *types.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logging_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLoggingConfiguration = &types.CloudWatchLoggingConfiguration{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+		}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			apiObject.CloudWatchLoggingConfiguration.EncryptionKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
+			apiObject.CloudWatchLoggingConfiguration.LogGroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+			apiObject.CloudWatchLoggingConfiguration.LogStreamNamePrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["managed_persistence_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ManagedPersistenceMonitoringConfiguration = &types.ManagedPersistenceMonitoringConfiguration{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+		}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			apiObject.ManagedPersistenceMonitoringConfiguration.EncryptionKeyArn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3MonitoringConfiguration = &types.S3MonitoringConfiguration{}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			apiObject.S3MonitoringConfiguration.EncryptionKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+			apiObject.S3MonitoringConfiguration.LogUri = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
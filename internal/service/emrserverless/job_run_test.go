@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfemrserverless "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessJobRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_emrserverless_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "emr-serverless", regexache.MustCompile(`/applications/.+/jobruns/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_run_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.6.0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrState,
+					"state_details",
+				},
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfemrserverless.ResourceJobRun(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, "execution_timeout_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.JobRunStateSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"configuration_overrides",
+					"wait_for_completion",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunExists(ctx context.Context, n string, v *types.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessClient(ctx)
+
+		output, err := tfemrserverless.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobRunDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emrserverless_job_run" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfemrserverless.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Finished job runs are retained by the service.
+			if state := output.State; state == types.JobRunStateSuccess || state == types.JobRunStateFailed {
+				continue
+			}
+
+			return fmt.Errorf("EMR Serverless Job Run %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccJobRunConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "emr-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "spark"
+}
+`, rName)
+}
+
+func testAccJobRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments   = ["10"]
+      spark_submit_parameters = "--conf spark.executor.cores=1 --conf spark.executor.memory=4g --conf spark.driver.cores=1 --conf spark.driver.memory=4g"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccJobRunConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id            = aws_emrserverless_application.test.id
+  execution_role_arn        = aws_iam_role.test.arn
+  execution_timeout_minutes = 30
+  name                      = %[1]q
+  wait_for_completion       = true
+
+  job_driver {
+    spark_submit {
+      entry_point           = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments = ["10"]
+    }
+  }
+
+  configuration_overrides {
+    monitoring_configuration {
+      managed_persistence_monitoring_configuration {
+        enabled = true
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -497,6 +497,21 @@ func resourceONTAPFileSystemUpdate(ctx context.Context, d *schema.ResourceData,
 		if _, err := waitFileSystemAdministrativeActionCompleted(ctx, conn, d.Id(), awstypes.AdministrativeActionTypeFileSystemUpdate, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for FSx for NetApp ONTAP File System (%s) administrative action (%s) complete: %s", d.Id(), awstypes.AdministrativeActionTypeFileSystemUpdate, err)
 		}
+
+		// Throughput and SSD IOPS changes are applied non-disruptively by a follow-on optimization action.
+		var optimizations []awstypes.AdministrativeActionType
+		if d.HasChanges("throughput_capacity", "throughput_capacity_per_ha_pair") {
+			optimizations = append(optimizations, awstypes.AdministrativeActionTypeThroughputOptimization)
+		}
+		if d.HasChange("disk_iops_configuration") {
+			optimizations = append(optimizations, awstypes.AdministrativeActionTypeIopsOptimization)
+		}
+
+		for _, actionType := range optimizations {
+			if _, err := waitFileSystemAdministrativeActionCompleted(ctx, conn, d.Id(), actionType, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for FSx for NetApp ONTAP File System (%s) administrative action (%s) complete: %s", d.Id(), actionType, err)
+			}
+		}
 	}
 
 	return append(diags, resourceONTAPFileSystemRead(ctx, d, meta)...)
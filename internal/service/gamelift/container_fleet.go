@@ -0,0 +1,773 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_gamelift_container_fleet", name="Container Fleet")
+// @Tags(identifierAttribute="arn")
+func newContainerFleetResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &containerFleetResource{}
+
+	r.SetDefaultCreateTimeout(70 * time.Minute)
+	r.SetDefaultUpdateTimeout(70 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type containerFleetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*containerFleetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_gamelift_container_fleet"
+}
+
+func (r *containerFleetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"billing_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerFleetBillingType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"fleet_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"game_server_container_group_definition_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"game_server_container_group_definition_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"game_server_container_groups_per_instance": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5000),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrInstanceType: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_game_server_container_groups_per_instance": schema.Int64Attribute{
+				Computed: true,
+			},
+			"metric_groups": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"new_game_session_protection_policy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProtectionPolicy](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"per_instance_container_group_definition_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"per_instance_container_group_definition_name": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerFleetStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"deployment_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[deploymentConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"impairment_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DeploymentImpairmentStrategy](),
+							Optional:   true,
+						},
+						"minimum_healthy_percentage": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(30, 75),
+							},
+						},
+						"protection_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DeploymentProtectionStrategy](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+			"game_session_creation_limit_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[gameSessionCreationLimitPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"new_game_sessions_per_creator": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"policy_period_in_minutes": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"instance_connection_port_range": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectionPortRangeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"from_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 60000),
+							},
+						},
+						"to_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 60000),
+							},
+						},
+					},
+				},
+			},
+			"instance_inbound_permission": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[ipPermissionModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"from_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 60000),
+							},
+						},
+						"ip_range": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.IpProtocol](),
+							Required:   true,
+						},
+						"to_port": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 60000),
+							},
+						},
+					},
+				},
+			},
+			"log_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[logConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"log_destination": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.LogDestination](),
+							Optional:   true,
+						},
+						"log_group_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						names.AttrS3BucketName: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *containerFleetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data containerFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	input := &gamelift.CreateContainerFleetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateContainerFleet(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating GameLift Container Fleet", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.ContainerFleet.FleetId)
+
+	// A fleet without a game server container group definition stops once created.
+	target := awstypes.ContainerFleetStatusActive
+	if data.GameServerContainerGroupDefinitionName.IsNull() {
+		target = awstypes.ContainerFleetStatusCreated
+	}
+
+	fleet, err := waitContainerFleetCreated(ctx, conn, data.ID.ValueString(), target, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Fleet (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, fleet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *containerFleetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data containerFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	fleet, err := findContainerFleetByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading GameLift Container Fleet (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, fleet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *containerFleetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new containerFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Description.Equal(old.Description) ||
+		!new.GameServerContainerGroupDefinitionName.Equal(old.GameServerContainerGroupDefinitionName) ||
+		!new.GameServerContainerGroupsPerInstance.Equal(old.GameServerContainerGroupsPerInstance) ||
+		!new.GameSessionCreationLimitPolicy.Equal(old.GameSessionCreationLimitPolicy) ||
+		!new.InstanceConnectionPortRange.Equal(old.InstanceConnectionPortRange) ||
+		!new.InstanceInboundPermissions.Equal(old.InstanceInboundPermissions) ||
+		!new.LogConfiguration.Equal(old.LogConfiguration) ||
+		!new.MetricGroups.Equal(old.MetricGroups) ||
+		!new.NewGameSessionProtectionPolicy.Equal(old.NewGameSessionProtectionPolicy) ||
+		!new.PerInstanceContainerGroupDefinitionName.Equal(old.PerInstanceContainerGroupDefinitionName) {
+		input := &gamelift.UpdateContainerFleetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FleetId = aws.String(id)
+
+		// Only send container group definitions when they change, as doing so starts a new deployment.
+		deploy := !new.GameServerContainerGroupDefinitionName.Equal(old.GameServerContainerGroupDefinitionName) ||
+			!new.PerInstanceContainerGroupDefinitionName.Equal(old.PerInstanceContainerGroupDefinitionName)
+		if !deploy {
+			input.DeploymentConfiguration = nil
+			input.GameServerContainerGroupDefinitionName = nil
+			input.PerInstanceContainerGroupDefinitionName = nil
+		}
+
+		if !new.InstanceInboundPermissions.Equal(old.InstanceInboundPermissions) {
+			var oldPermissions, newPermissions []awstypes.IpPermission
+			response.Diagnostics.Append(fwflex.Expand(ctx, old.InstanceInboundPermissions, &oldPermissions)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.InstanceInboundPermissions, &newPermissions)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.InstanceInboundPermissionAuthorizations, input.InstanceInboundPermissionRevocations = diffIPPermissions(oldPermissions, newPermissions)
+		}
+
+		output, err := conn.UpdateContainerFleet(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating GameLift Container Fleet (%s)", id), err.Error())
+
+			return
+		}
+
+		timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+		if deploy && output.ContainerFleet != nil && output.ContainerFleet.DeploymentDetails != nil && output.ContainerFleet.DeploymentDetails.LatestDeploymentId != nil {
+			deploymentID := aws.ToString(output.ContainerFleet.DeploymentDetails.LatestDeploymentId)
+
+			if _, err := waitFleetDeploymentCompleted(ctx, conn, id, deploymentID, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Fleet (%s) deployment (%s)", id, deploymentID), err.Error())
+
+				return
+			}
+		}
+
+		fleet, err := waitContainerFleetUpdated(ctx, conn, id, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Fleet (%s) update", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, fleet)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *containerFleetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data containerFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	id := data.ID.ValueString()
+	_, err := conn.DeleteContainerFleet(ctx, &gamelift.DeleteContainerFleetInput{
+		FleetId: aws.String(id),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting GameLift Container Fleet (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitContainerFleetDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Fleet (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *containerFleetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findContainerFleetByID(ctx context.Context, conn *gamelift.Client, id string) (*awstypes.ContainerFleet, error) {
+	input := &gamelift.DescribeContainerFleetInput{
+		FleetId: aws.String(id),
+	}
+
+	return findContainerFleet(ctx, conn, input)
+}
+
+func findContainerFleet(ctx context.Context, conn *gamelift.Client, input *gamelift.DescribeContainerFleetInput) (*awstypes.ContainerFleet, error) {
+	output, err := conn.DescribeContainerFleet(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerFleet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerFleet, nil
+}
+
+func findFleetDeploymentByTwoPartKey(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string) (*awstypes.FleetDeployment, error) {
+	input := &gamelift.DescribeFleetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+		FleetId:      aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeFleetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FleetDeployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FleetDeployment, nil
+}
+
+func statusContainerFleet(ctx context.Context, conn *gamelift.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findContainerFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusFleetDeployment(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFleetDeploymentByTwoPartKey(ctx, conn, fleetID, deploymentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DeploymentStatus), nil
+	}
+}
+
+func waitContainerFleetCreated(ctx context.Context, conn *gamelift.Client, id string, target awstypes.ContainerFleetStatus, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerFleetStatusPending,
+			awstypes.ContainerFleetStatusCreating,
+			awstypes.ContainerFleetStatusCreated,
+			awstypes.ContainerFleetStatusActivating,
+		),
+		Target:  enum.Slice(target),
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerFleetUpdated(ctx context.Context, conn *gamelift.Client, id string, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ContainerFleetStatusUpdating, awstypes.ContainerFleetStatusActivating),
+		Target:  enum.Slice(awstypes.ContainerFleetStatusActive, awstypes.ContainerFleetStatusCreated),
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerFleetDeleted(ctx context.Context, conn *gamelift.Client, id string, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerFleetStatusActive,
+			awstypes.ContainerFleetStatusActivating,
+			awstypes.ContainerFleetStatusCreated,
+			awstypes.ContainerFleetStatusDeleting,
+			awstypes.ContainerFleetStatusUpdating,
+		),
+		Target:  []string{},
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeploymentCompleted(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string, timeout time.Duration) (*awstypes.FleetDeployment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeploymentStatusPending, awstypes.DeploymentStatusInProgress),
+		Target:  enum.Slice(awstypes.DeploymentStatusComplete),
+		Refresh: statusFleetDeployment(ctx, conn, fleetID, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FleetDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// diffIPPermissions returns the permissions to authorize and revoke to move from old to new.
+func diffIPPermissions(old, new []awstypes.IpPermission) ([]awstypes.IpPermission, []awstypes.IpPermission) {
+	key := func(apiObject awstypes.IpPermission) string {
+		return fmt.Sprintf("%d-%d-%s-%s", aws.ToInt32(apiObject.FromPort), aws.ToInt32(apiObject.ToPort), apiObject.Protocol, aws.ToString(apiObject.IpRange))
+	}
+
+	oldPermissions := make(map[string]awstypes.IpPermission)
+	for _, apiObject := range old {
+		oldPermissions[key(apiObject)] = apiObject
+	}
+
+	newPermissions := make(map[string]awstypes.IpPermission)
+	for _, apiObject := range new {
+		newPermissions[key(apiObject)] = apiObject
+	}
+
+	var authorizations, revocations []awstypes.IpPermission
+
+	for k, apiObject := range newPermissions {
+		if _, ok := oldPermissions[k]; !ok {
+			authorizations = append(authorizations, apiObject)
+		}
+	}
+
+	for k, apiObject := range oldPermissions {
+		if _, ok := newPermissions[k]; !ok {
+			revocations = append(revocations, apiObject)
+		}
+	}
+
+	return authorizations, revocations
+}
+
+type containerFleetResourceModel struct {
+	ARN                                         types.String                                                         `tfsdk:"arn"`
+	BillingType                                 fwtypes.StringEnum[awstypes.ContainerFleetBillingType]               `tfsdk:"billing_type"`
+	DeploymentConfiguration                     fwtypes.ListNestedObjectValueOf[deploymentConfigurationModel]        `tfsdk:"deployment_configuration"`
+	Description                                 types.String                                                         `tfsdk:"description"`
+	FleetRoleARN                                fwtypes.ARN                                                          `tfsdk:"fleet_role_arn"`
+	GameServerContainerGroupDefinitionARN       types.String                                                         `tfsdk:"game_server_container_group_definition_arn"`
+	GameServerContainerGroupDefinitionName      types.String                                                         `tfsdk:"game_server_container_group_definition_name"`
+	GameServerContainerGroupsPerInstance        types.Int64                                                          `tfsdk:"game_server_container_groups_per_instance"`
+	GameSessionCreationLimitPolicy              fwtypes.ListNestedObjectValueOf[gameSessionCreationLimitPolicyModel] `tfsdk:"game_session_creation_limit_policy"`
+	ID                                          types.String                                                         `tfsdk:"id"`
+	InstanceConnectionPortRange                 fwtypes.ListNestedObjectValueOf[connectionPortRangeModel]            `tfsdk:"instance_connection_port_range"`
+	InstanceInboundPermissions                  fwtypes.SetNestedObjectValueOf[ipPermissionModel]                    `tfsdk:"instance_inbound_permission"`
+	InstanceType                                types.String                                                         `tfsdk:"instance_type"`
+	LogConfiguration                            fwtypes.ListNestedObjectValueOf[logConfigurationModel]               `tfsdk:"log_configuration"`
+	MaximumGameServerContainerGroupsPerInstance types.Int64                                                          `tfsdk:"maximum_game_server_container_groups_per_instance"`
+	MetricGroups                                fwtypes.ListValueOf[types.String]                                    `tfsdk:"metric_groups"`
+	NewGameSessionProtectionPolicy              fwtypes.StringEnum[awstypes.ProtectionPolicy]                        `tfsdk:"new_game_session_protection_policy"`
+	PerInstanceContainerGroupDefinitionARN      types.String                                                         `tfsdk:"per_instance_container_group_definition_arn"`
+	PerInstanceContainerGroupDefinitionName     types.String                                                         `tfsdk:"per_instance_container_group_definition_name"`
+	Status                                      fwtypes.StringEnum[awstypes.ContainerFleetStatus]                    `tfsdk:"status"`
+	Tags                                        tftags.Map                                                           `tfsdk:"tags"`
+	TagsAll                                     tftags.Map                                                           `tfsdk:"tags_all"`
+	Timeouts                                    timeouts.Value                                                       `tfsdk:"timeouts"`
+}
+
+func (m *containerFleetResourceModel) flatten(ctx context.Context, fleet *awstypes.ContainerFleet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// GameLift assigns values to these blocks when they are omitted; only track them when configured.
+	gameSessionCreationLimitPolicy, instanceConnectionPortRange, instanceInboundPermissions, logConfiguration := m.GameSessionCreationLimitPolicy, m.InstanceConnectionPortRange, m.InstanceInboundPermissions, m.LogConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, fleet, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = fwflex.StringToFramework(ctx, fleet.FleetArn)
+	m.ID = fwflex.StringToFramework(ctx, fleet.FleetId)
+
+	if len(gameSessionCreationLimitPolicy.Elements()) == 0 {
+		m.GameSessionCreationLimitPolicy = gameSessionCreationLimitPolicy
+	}
+	if len(instanceConnectionPortRange.Elements()) == 0 {
+		m.InstanceConnectionPortRange = instanceConnectionPortRange
+	}
+	if len(instanceInboundPermissions.Elements()) == 0 {
+		m.InstanceInboundPermissions = instanceInboundPermissions
+	}
+	if len(logConfiguration.Elements()) == 0 {
+		m.LogConfiguration = logConfiguration
+	}
+
+	return diags
+}
+
+type deploymentConfigurationModel struct {
+	ImpairmentStrategy       fwtypes.StringEnum[awstypes.DeploymentImpairmentStrategy] `tfsdk:"impairment_strategy"`
+	MinimumHealthyPercentage types.Int64                                               `tfsdk:"minimum_healthy_percentage"`
+	ProtectionStrategy       fwtypes.StringEnum[awstypes.DeploymentProtectionStrategy] `tfsdk:"protection_strategy"`
+}
+
+type gameSessionCreationLimitPolicyModel struct {
+	NewGameSessionsPerCreator types.Int64 `tfsdk:"new_game_sessions_per_creator"`
+	PolicyPeriodInMinutes     types.Int64 `tfsdk:"policy_period_in_minutes"`
+}
+
+type connectionPortRangeModel struct {
+	FromPort types.Int64 `tfsdk:"from_port"`
+	ToPort   types.Int64 `tfsdk:"to_port"`
+}
+
+type ipPermissionModel struct {
+	FromPort types.Int64                             `tfsdk:"from_port"`
+	IPRange  types.String                            `tfsdk:"ip_range"`
+	Protocol fwtypes.StringEnum[awstypes.IpProtocol] `tfsdk:"protocol"`
+	ToPort   types.Int64                             `tfsdk:"to_port"`
+}
+
+type logConfigurationModel struct {
+	LogDestination fwtypes.StringEnum[awstypes.LogDestination] `tfsdk:"log_destination"`
+	LogGroupARN    fwtypes.ARN                                 `tfsdk:"log_group_arn"`
+	S3BucketName   types.String                                `tfsdk:"s3_bucket_name"`
+}
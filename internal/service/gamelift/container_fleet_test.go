@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftContainerFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`containerfleet/containerfleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "billing_type", string(awstypes.ContainerFleetBillingTypeOnDemand)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test1"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "game_server_container_group_definition_name", "aws_gamelift_container_group_definition.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "instance_connection_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_connection_port_range.0.from_port", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "instance_connection_port_range.0.to_port", "10100"),
+					resource.TestCheckResourceAttr(resourceName, "instance_inbound_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerFleetStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment_configuration"},
+			},
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerFleet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_deployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.ContainerFleet
+	resourceName := "aws_gamelift_container_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_deployment(rName, imageURI, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "game_server_container_group_definition_name", "aws_gamelift_container_group_definition.first", names.AttrName),
+				),
+			},
+			{
+				Config: testAccContainerFleetConfig_deployment(rName, imageURI, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "game_server_container_group_definition_name", "aws_gamelift_container_group_definition.second", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerFleetStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerFleetExists(ctx context.Context, n string, v *awstypes.ContainerFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		output, err := tfgamelift.FindContainerFleetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_fleet" {
+				continue
+			}
+
+			_, err := tfgamelift.FindContainerFleetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerFleetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "gamelift.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/GameLiftContainerFleetPolicy"
+}
+`, rName)
+}
+
+func testAccContainerFleetConfig_basic(rName, imageURI, description string) string {
+	return acctest.ConfigCompose(
+		testAccContainerFleetConfig_base(rName),
+		testAccContainerGroupDefinitionConfig_basic(rName, imageURI, 1024),
+		fmt.Sprintf(`
+resource "aws_gamelift_container_fleet" "test" {
+  description                                 = %[1]q
+  fleet_role_arn                              = aws_iam_role.test.arn
+  game_server_container_group_definition_name = aws_gamelift_container_group_definition.test.name
+
+  instance_connection_port_range {
+    from_port = 10000
+    to_port   = 10100
+  }
+
+  instance_inbound_permission {
+    from_port = 10000
+    ip_range  = "10.0.0.0/8"
+    protocol  = "UDP"
+    to_port   = 10100
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, description))
+}
+
+func testAccContainerFleetConfig_deployment(rName, imageURI, selectDefinition string) string {
+	return acctest.ConfigCompose(
+		testAccContainerFleetConfig_base(rName),
+		fmt.Sprintf(`
+locals {
+  definitions = {
+    "first"  = aws_gamelift_container_group_definition.first.name
+    "second" = aws_gamelift_container_group_definition.second.name
+  }
+}
+
+resource "aws_gamelift_container_group_definition" "first" {
+  name                         = "%[1]s-1"
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_ranges {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+}
+
+resource "aws_gamelift_container_group_definition" "second" {
+  name                         = "%[1]s-2"
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 2048
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_ranges {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+}
+
+resource "aws_gamelift_container_fleet" "test" {
+  fleet_role_arn                              = aws_iam_role.test.arn
+  game_server_container_group_definition_name = local.definitions[%[3]q]
+
+  deployment_configuration {
+    impairment_strategy        = "ROLLBACK"
+    minimum_healthy_percentage = 50
+    protection_strategy        = "IGNORE_PROTECTION"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, imageURI, selectDefinition))
+}
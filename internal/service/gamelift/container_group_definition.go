@@ -0,0 +1,681 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_gamelift_container_group_definition", name="Container Group Definition")
+// @Tags(identifierAttribute="arn")
+func newContainerGroupDefinitionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &containerGroupDefinitionResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type containerGroupDefinitionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*containerGroupDefinitionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_gamelift_container_group_definition"
+}
+
+func (r *containerGroupDefinitionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"container_group_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerGroupType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"operating_system": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerOperatingSystem](),
+				Required:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerGroupDefinitionStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusReason: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"total_memory_limit_mebibytes": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(4, 1024000),
+				},
+			},
+			"total_vcpu_limit": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.125, 10),
+				},
+			},
+			"version_description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"game_server_container_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[gameServerContainerDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"container_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"image_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"resolved_image_digest": schema.StringAttribute{
+							Computed: true,
+						},
+						"server_sdk_version": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"depends_on":           containerDependencyBlock(ctx),
+						"environment_override": containerEnvironmentBlock(ctx),
+						"mount_points":         containerMountPointBlock(ctx),
+						"port_configuration":   containerPortConfigurationBlock(ctx),
+					},
+				},
+			},
+			"support_container_definitions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[supportContainerDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(9),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"container_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"essential": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"image_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"memory_hard_limit_mebibytes": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(4, 1024000),
+							},
+						},
+						"resolved_image_digest": schema.StringAttribute{
+							Computed: true,
+						},
+						"vcpu": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0.125, 10),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"depends_on":           containerDependencyBlock(ctx),
+						"environment_override": containerEnvironmentBlock(ctx),
+						names.AttrHealthCheck: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerHealthCheckModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 20),
+										},
+									},
+									names.AttrInterval: schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.Between(60, 300),
+										},
+									},
+									"retries": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.Between(5, 10),
+										},
+									},
+									"start_period": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 300),
+										},
+									},
+									names.AttrTimeout: schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.Between(30, 60),
+										},
+									},
+								},
+							},
+						},
+						"mount_points":       containerMountPointBlock(ctx),
+						"port_configuration": containerPortConfigurationBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func containerDependencyBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[containerDependencyModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrCondition: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ContainerDependencyCondition](),
+					Required:   true,
+				},
+				"container_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func containerEnvironmentBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[containerEnvironmentModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(20),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 255),
+					},
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 255),
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerMountPointBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[containerMountPointModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"access_level": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ContainerMountPointAccessLevel](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"container_path": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"instance_path": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func containerPortConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[containerPortConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"container_port_ranges": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[containerPortRangeModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeBetween(1, 100),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"from_port": schema.Int64Attribute{
+								Required: true,
+								Validators: []validator.Int64{
+									int64validator.Between(1, 60000),
+								},
+							},
+							names.AttrProtocol: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.IpProtocol](),
+								Required:   true,
+							},
+							"to_port": schema.Int64Attribute{
+								Required: true,
+								Validators: []validator.Int64{
+									int64validator.Between(1, 60000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *containerGroupDefinitionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data containerGroupDefinitionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &gamelift.CreateContainerGroupDefinitionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateContainerGroupDefinition(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating GameLift Container Group Definition (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	output, err := waitContainerGroupDefinitionReady(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Group Definition (%s) create", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *containerGroupDefinitionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data containerGroupDefinitionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	output, err := findContainerGroupDefinitionByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading GameLift Container Group Definition (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *containerGroupDefinitionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new containerGroupDefinitionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	if !new.GameServerContainerDefinition.Equal(old.GameServerContainerDefinition) ||
+		!new.OperatingSystem.Equal(old.OperatingSystem) ||
+		!new.SupportContainerDefinitions.Equal(old.SupportContainerDefinitions) ||
+		!new.TotalMemoryLimitMebibytes.Equal(old.TotalMemoryLimitMebibytes) ||
+		!new.TotalVcpuLimit.Equal(old.TotalVcpuLimit) ||
+		!new.VersionDescription.Equal(old.VersionDescription) {
+		// Each update creates a new version of the container group definition.
+		input := &gamelift.UpdateContainerGroupDefinitionInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateContainerGroupDefinition(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating GameLift Container Group Definition (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitContainerGroupDefinitionReady(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for GameLift Container Group Definition (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *containerGroupDefinitionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data containerGroupDefinitionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GameLiftClient(ctx)
+
+	// Deleting without a version number removes all versions.
+	_, err := conn.DeleteContainerGroupDefinition(ctx, &gamelift.DeleteContainerGroupDefinitionInput{
+		Name: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting GameLift Container Group Definition (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *containerGroupDefinitionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findContainerGroupDefinitionByName(ctx context.Context, conn *gamelift.Client, name string) (*awstypes.ContainerGroupDefinition, error) {
+	input := &gamelift.DescribeContainerGroupDefinitionInput{
+		Name: aws.String(name),
+	}
+
+	return findContainerGroupDefinition(ctx, conn, input)
+}
+
+func findContainerGroupDefinition(ctx context.Context, conn *gamelift.Client, input *gamelift.DescribeContainerGroupDefinitionInput) (*awstypes.ContainerGroupDefinition, error) {
+	output, err := conn.DescribeContainerGroupDefinition(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerGroupDefinition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerGroupDefinition, nil
+}
+
+func statusContainerGroupDefinition(ctx context.Context, conn *gamelift.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findContainerGroupDefinitionByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitContainerGroupDefinitionReady(ctx context.Context, conn *gamelift.Client, name string, timeout time.Duration) (*awstypes.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ContainerGroupDefinitionStatusCopying),
+		Target:  enum.Slice(awstypes.ContainerGroupDefinitionStatusReady),
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerGroupDefinition); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type containerGroupDefinitionResourceModel struct {
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	ContainerGroupType            fwtypes.StringEnum[awstypes.ContainerGroupType]                     `tfsdk:"container_group_type"`
+	GameServerContainerDefinition fwtypes.ListNestedObjectValueOf[gameServerContainerDefinitionModel] `tfsdk:"game_server_container_definition"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	Name                          types.String                                                        `tfsdk:"name"`
+	OperatingSystem               fwtypes.StringEnum[awstypes.ContainerOperatingSystem]               `tfsdk:"operating_system"`
+	Status                        fwtypes.StringEnum[awstypes.ContainerGroupDefinitionStatus]         `tfsdk:"status"`
+	StatusReason                  types.String                                                        `tfsdk:"status_reason"`
+	SupportContainerDefinitions   fwtypes.ListNestedObjectValueOf[supportContainerDefinitionModel]    `tfsdk:"support_container_definitions"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                      `tfsdk:"timeouts"`
+	TotalMemoryLimitMebibytes     types.Int64                                                         `tfsdk:"total_memory_limit_mebibytes"`
+	TotalVcpuLimit                types.Float64                                                       `tfsdk:"total_vcpu_limit"`
+	VersionDescription            types.String                                                        `tfsdk:"version_description"`
+	VersionNumber                 types.Int64                                                         `tfsdk:"version_number"`
+}
+
+func (m *containerGroupDefinitionResourceModel) flatten(ctx context.Context, apiObject *awstypes.ContainerGroupDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = fwflex.StringToFramework(ctx, apiObject.ContainerGroupDefinitionArn)
+	m.ID = fwflex.StringToFramework(ctx, apiObject.Name)
+
+	return diags
+}
+
+type gameServerContainerDefinitionModel struct {
+	ContainerName       types.String                                                     `tfsdk:"container_name"`
+	DependsOn           fwtypes.ListNestedObjectValueOf[containerDependencyModel]        `tfsdk:"depends_on"`
+	EnvironmentOverride fwtypes.ListNestedObjectValueOf[containerEnvironmentModel]       `tfsdk:"environment_override"`
+	ImageURI            types.String                                                     `tfsdk:"image_uri"`
+	MountPoints         fwtypes.ListNestedObjectValueOf[containerMountPointModel]        `tfsdk:"mount_points"`
+	PortConfiguration   fwtypes.ListNestedObjectValueOf[containerPortConfigurationModel] `tfsdk:"port_configuration"`
+	ResolvedImageDigest types.String                                                     `tfsdk:"resolved_image_digest"`
+	ServerSDKVersion    types.String                                                     `tfsdk:"server_sdk_version"`
+}
+
+type supportContainerDefinitionModel struct {
+	ContainerName            types.String                                                     `tfsdk:"container_name"`
+	DependsOn                fwtypes.ListNestedObjectValueOf[containerDependencyModel]        `tfsdk:"depends_on"`
+	EnvironmentOverride      fwtypes.ListNestedObjectValueOf[containerEnvironmentModel]       `tfsdk:"environment_override"`
+	Essential                types.Bool                                                       `tfsdk:"essential"`
+	HealthCheck              fwtypes.ListNestedObjectValueOf[containerHealthCheckModel]       `tfsdk:"health_check"`
+	ImageURI                 types.String                                                     `tfsdk:"image_uri"`
+	MemoryHardLimitMebibytes types.Int64                                                      `tfsdk:"memory_hard_limit_mebibytes"`
+	MountPoints              fwtypes.ListNestedObjectValueOf[containerMountPointModel]        `tfsdk:"mount_points"`
+	PortConfiguration        fwtypes.ListNestedObjectValueOf[containerPortConfigurationModel] `tfsdk:"port_configuration"`
+	ResolvedImageDigest      types.String                                                     `tfsdk:"resolved_image_digest"`
+	Vcpu                     types.Float64                                                    `tfsdk:"vcpu"`
+}
+
+type containerDependencyModel struct {
+	Condition     fwtypes.StringEnum[awstypes.ContainerDependencyCondition] `tfsdk:"condition"`
+	ContainerName types.String                                              `tfsdk:"container_name"`
+}
+
+type containerEnvironmentModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type containerHealthCheckModel struct {
+	Command     fwtypes.ListValueOf[types.String] `tfsdk:"command"`
+	Interval    types.Int64                       `tfsdk:"interval"`
+	Retries     types.Int64                       `tfsdk:"retries"`
+	StartPeriod types.Int64                       `tfsdk:"start_period"`
+	Timeout     types.Int64                       `tfsdk:"timeout"`
+}
+
+type containerMountPointModel struct {
+	AccessLevel   fwtypes.StringEnum[awstypes.ContainerMountPointAccessLevel] `tfsdk:"access_level"`
+	ContainerPath types.String                                                `tfsdk:"container_path"`
+	InstancePath  types.String                                                `tfsdk:"instance_path"`
+}
+
+type containerPortConfigurationModel struct {
+	ContainerPortRanges fwtypes.ListNestedObjectValueOf[containerPortRangeModel] `tfsdk:"container_port_ranges"`
+}
+
+type containerPortRangeModel struct {
+	FromPort types.Int64                             `tfsdk:"from_port"`
+	Protocol fwtypes.StringEnum[awstypes.IpProtocol] `tfsdk:"protocol"`
+	ToPort   types.Int64                             `tfsdk:"to_port"`
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Container images must be stored in an Amazon ECR private repository in the test Region.
+const envVarContainerImageURI = "GAMELIFT_CONTAINER_IMAGE_URI"
+
+func TestAccGameLiftContainerGroupDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	resourceName := "aws_gamelift_container_group_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI, 1024),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`containergroupdefinition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_group_type", string(awstypes.ContainerGroupTypeGameServer)),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.0.container_name", "server"),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.0.port_configuration.0.container_port_ranges.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "game_server_container_definition.0.resolved_image_digest"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", string(awstypes.ContainerOperatingSystemAmazonLinux2023)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerGroupDefinitionStatusReady)),
+					resource.TestCheckResourceAttr(resourceName, "total_memory_limit_mebibytes", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI, 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "total_memory_limit_mebibytes", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	resourceName := "aws_gamelift_container_group_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerGroupDefinition, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_supportContainer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	resourceName := "aws_gamelift_container_group_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_supportContainer(rName, imageURI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "container_group_type", string(awstypes.ContainerGroupTypePerInstance)),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definitions.0.container_name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definitions.0.environment_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definitions.0.health_check.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerGroupDefinitionExists(ctx context.Context, n string, v *awstypes.ContainerGroupDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		output, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerGroupDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_group_definition" {
+				continue
+			}
+
+			_, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Group Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerGroupDefinitionConfig_basic(rName, imageURI string, memory int) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = %[3]d
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_ranges {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+}
+`, rName, imageURI, memory)
+}
+
+func testAccContainerGroupDefinitionConfig_supportContainer(rName, imageURI string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  container_group_type         = "PER_INSTANCE"
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  support_container_definitions {
+    container_name = "sidecar"
+    essential      = true
+    image_uri      = %[2]q
+
+    environment_override {
+      name  = "LOG_LEVEL"
+      value = "debug"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "exit 0"]
+    }
+  }
+}
+`, rName, imageURI)
+}
@@ -6,6 +6,8 @@ package glue
 // Exports for use in tests only.
 var (
 	ResourceCatalogTableOptimizer = newResourceCatalogTableOptimizer
+	ResourceUsageProfile          = resourceUsageProfile
 
 	FindCatalogTableOptimizer = findCatalogTableOptimizer
+	FindUsageProfileByName    = findUsageProfileByName
 )
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
@@ -92,6 +93,7 @@ func ResourceJob() *schema.Resource {
 			"execution_class": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.ExecutionClass](),
 			},
 			"execution_property": {
@@ -126,8 +128,9 @@ func ResourceJob() *schema.Resource {
 				ConflictsWith: []string{"number_of_workers", "worker_type"},
 			},
 			"maintenance_window": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(Sun|Mon|Tue|Wed|Thu|Fri|Sat):([01]?[0-9]|2[0-3])$`), "must be of the format <Day>:<Hour>, e.g. Sun:3"),
 			},
 			"max_retries": {
 				Type:         schema.TypeInt,
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueUsageProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "glue", fmt.Sprintf("usageProfile/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.job_configuration.*", map[string]string{
+						names.AttrName:         "numberOfWorkers",
+						names.AttrDefaultValue: "10",
+						"max_value":            "50",
+						"min_value":            "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfglue.ResourceUsageProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_basic(rName, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccUsageProfileConfig_updated(rName, "20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.job_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.job_configuration.*", map[string]string{
+						names.AttrName:         "numberOfWorkers",
+						names.AttrDefaultValue: "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.0.job_configuration.*", map[string]string{
+						names.AttrName:         "workerType",
+						names.AttrDefaultValue: "G.1X",
+						"allowed_values.#":     "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.session_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueUsageProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v glue.GetUsageProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_usage_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageProfileConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsageProfileConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccUsageProfileConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsageProfileExists(ctx context.Context, n string, v *glue.GetUsageProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueClient(ctx)
+
+		output, err := tfglue.FindUsageProfileByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUsageProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glue_usage_profile" {
+				continue
+			}
+
+			_, err := tfglue.FindUsageProfileByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Glue Usage Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccUsageProfileConfig_basic(rName, numberOfWorkers string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      name          = "numberOfWorkers"
+      default_value = %[2]q
+      max_value     = "50"
+      min_value     = "2"
+    }
+  }
+}
+`, rName, numberOfWorkers)
+}
+
+func testAccUsageProfileConfig_updated(rName, numberOfWorkers string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  configuration {
+    job_configuration {
+      name          = "numberOfWorkers"
+      default_value = %[2]q
+      max_value     = "50"
+      min_value     = "2"
+    }
+
+    job_configuration {
+      name           = "workerType"
+      default_value  = "G.1X"
+      allowed_values = ["G.1X", "G.2X"]
+    }
+
+    session_configuration {
+      name          = "idleTimeout"
+      default_value = "30"
+      max_value     = "120"
+      min_value     = "10"
+    }
+  }
+}
+`, rName, numberOfWorkers)
+}
+
+func testAccUsageProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      name          = "numberOfWorkers"
+      default_value = "10"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccUsageProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_usage_profile" "test" {
+  name = %[1]q
+
+  configuration {
+    job_configuration {
+      name          = "numberOfWorkers"
+      default_value = "10"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -5,6 +5,7 @@ package grafana
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -37,6 +38,7 @@ func resourceWorkspaceSAMLConfiguration() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -215,6 +217,11 @@ func resourceWorkspaceSAMLConfigurationUpsert(ctx context.Context, d *schema.Res
 		WorkspaceId:             aws.String(workspaceID),
 	}
 
+	action, timeout := "create", d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		action, timeout = "update", d.Timeout(schema.TimeoutUpdate)
+	}
+
 	_, err = conn.UpdateWorkspaceAuthentication(ctx, input)
 
 	if err != nil {
@@ -225,8 +232,13 @@ func resourceWorkspaceSAMLConfigurationUpsert(ctx context.Context, d *schema.Res
 		d.SetId(workspaceID)
 	}
 
-	if _, err := waitWorkspaceSAMLConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Grafana Workspace SAML Configuration (%s) create: %s", d.Id(), err)
+	if _, err := waitWorkspaceSAMLConfigurationCreated(ctx, conn, d.Id(), timeout); err != nil {
+		// An invalid configuration, e.g. unusable IdP metadata, is accepted but never becomes CONFIGURED.
+		if tfresource.NotFound(err) {
+			err = fmt.Errorf("status is %s, check the IdP metadata and assertion attributes", awstypes.SamlConfigurationStatusNotConfigured)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for Grafana Workspace SAML Configuration (%s) %s: %s", d.Id(), action, err)
 	}
 
 	return append(diags, resourceWorkspaceSAMLConfigurationRead(ctx, d, meta)...)
@@ -248,24 +260,37 @@ func resourceWorkspaceSAMLConfigurationRead(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace SAML Configuration (%s): %s", d.Id(), err)
 	}
 
-	d.Set("admin_role_values", saml.Configuration.RoleValues.Admin)
-	d.Set("allowed_organizations", saml.Configuration.AllowedOrganizations)
-	d.Set("editor_role_values", saml.Configuration.RoleValues.Editor)
-	d.Set("email_assertion", saml.Configuration.AssertionAttributes.Email)
-	d.Set("groups_assertion", saml.Configuration.AssertionAttributes.Groups)
+	configuration := saml.Configuration
+	if configuration == nil {
+		configuration = &awstypes.SamlConfiguration{}
+	}
+	assertionAttributes := configuration.AssertionAttributes
+	if assertionAttributes == nil {
+		assertionAttributes = &awstypes.AssertionAttributes{}
+	}
+	roleValues := configuration.RoleValues
+	if roleValues == nil {
+		roleValues = &awstypes.RoleValues{}
+	}
+
+	d.Set("admin_role_values", roleValues.Admin)
+	d.Set("allowed_organizations", configuration.AllowedOrganizations)
+	d.Set("editor_role_values", roleValues.Editor)
+	d.Set("email_assertion", assertionAttributes.Email)
+	d.Set("groups_assertion", assertionAttributes.Groups)
 	d.Set("idp_metadata_url", "")
 	d.Set("idp_metadata_xml", "")
-	switch v := saml.Configuration.IdpMetadata.(type) {
+	switch v := configuration.IdpMetadata.(type) {
 	case *awstypes.IdpMetadataMemberUrl:
 		d.Set("idp_metadata_url", v.Value)
 	case *awstypes.IdpMetadataMemberXml:
 		d.Set("idp_metadata_xml", v.Value)
 	}
-	d.Set("login_assertion", saml.Configuration.AssertionAttributes.Login)
-	d.Set("login_validity_duration", saml.Configuration.LoginValidityDuration)
-	d.Set("name_assertion", saml.Configuration.AssertionAttributes.Name)
-	d.Set("org_assertion", saml.Configuration.AssertionAttributes.Org)
-	d.Set("role_assertion", saml.Configuration.AssertionAttributes.Role)
+	d.Set("login_assertion", assertionAttributes.Login)
+	d.Set("login_validity_duration", configuration.LoginValidityDuration)
+	d.Set("name_assertion", assertionAttributes.Name)
+	d.Set("org_assertion", assertionAttributes.Org)
+	d.Set("role_assertion", assertionAttributes.Role)
 	d.Set(names.AttrStatus, saml.Status)
 
 	return diags
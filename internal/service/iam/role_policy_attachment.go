@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_iam_role_policy_attachment", name="Role Policy Attachment")
+// @OverrideProvider
 func resourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyAttachmentCreate,
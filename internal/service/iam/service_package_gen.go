@@ -97,10 +97,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Principal Policy Simulation",
 		},
 		{
-			Factory:  dataSourceRole,
-			TypeName: "aws_iam_role",
-			Name:     "Role",
-			Tags:     &types.ServicePackageResourceTags{},
+			Factory:          dataSourceRole,
+			TypeName:         "aws_iam_role",
+			Name:             "Role",
+			Tags:             &types.ServicePackageResourceTags{},
+			OverrideProvider: true,
 		},
 		{
 			Factory:  dataSourceRoles,
@@ -218,16 +219,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			OverrideProvider: true,
 		},
 		{
-			Factory:  resourceRolePolicy,
-			TypeName: "aws_iam_role_policy",
-			Name:     "Role Policy",
+			Factory:          resourceRolePolicy,
+			TypeName:         "aws_iam_role_policy",
+			Name:             "Role Policy",
+			OverrideProvider: true,
 		},
 		{
-			Factory:  resourceRolePolicyAttachment,
-			TypeName: "aws_iam_role_policy_attachment",
-			Name:     "Role Policy Attachment",
+			Factory:          resourceRolePolicyAttachment,
+			TypeName:         "aws_iam_role_policy_attachment",
+			Name:             "Role Policy Attachment",
+			OverrideProvider: true,
 		},
 		{
 			Factory:  resourceSAMLProvider,
@@ -15,8 +15,9 @@ func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccIndexingConfiguration_basic,
-		"allAttributes": testAccIndexingConfiguration_allAttributes,
+		acctest.CtBasic:     testAccIndexingConfiguration_basic,
+		"allAttributes":     testAccIndexingConfiguration_allAttributes,
+		"thingGroupDynamic": testAccThingGroup_dynamic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
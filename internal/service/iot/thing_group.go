@@ -12,10 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -38,11 +40,22 @@ func resourceThingGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"query_string"},
+			},
 			"metadata": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -82,10 +95,11 @@ func resourceThingGroup() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 			"parent_group_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 128),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+				ConflictsWith: []string{"query_string"},
 			},
 			names.AttrProperties: {
 				Type:     schema.TypeList,
@@ -114,6 +128,21 @@ func resourceThingGroup() *schema.Resource {
 					},
 				},
 			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"query_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"query_string"},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVersion: {
@@ -122,7 +151,13 @@ func resourceThingGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A static thing group cannot be converted to a dynamic thing group, or vice versa.
+			customdiff.ForceNewIfChange("query_string", func(_ context.Context, old, new, meta interface{}) bool {
+				return (old.(string) == "") != (new.(string) == "")
+			}),
+		),
 	}
 }
 
@@ -131,26 +166,59 @@ func resourceThingGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).IoTClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
-	input := &iot.CreateThingGroupInput{
-		Tags:           getTagsIn(ctx),
-		ThingGroupName: aws.String(name),
-	}
 
-	if v, ok := d.GetOk("parent_group_name"); ok {
-		input.ParentGroupName = aws.String(v.(string))
-	}
+	if v, ok := d.GetOk("query_string"); ok {
+		input := &iot.CreateDynamicThingGroupInput{
+			QueryString:    aws.String(v.(string)),
+			Tags:           getTagsIn(ctx),
+			ThingGroupName: aws.String(name),
+		}
 
-	if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
-	}
+		if v, ok := d.GetOk("index_name"); ok {
+			input.IndexName = aws.String(v.(string))
+		}
 
-	output, err := conn.CreateThingGroup(ctx, input)
+		if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating IoT Thing Group (%s): %s", name, err)
-	}
+		if v, ok := d.GetOk("query_version"); ok {
+			input.QueryVersion = aws.String(v.(string))
+		}
+
+		output, err := conn.CreateDynamicThingGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT Dynamic Thing Group (%s): %s", name, err)
+		}
+
+		d.SetId(aws.ToString(output.ThingGroupName))
+
+		if _, err := waitDynamicThingGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Dynamic Thing Group (%s) create: %s", d.Id(), err)
+		}
+	} else {
+		input := &iot.CreateThingGroupInput{
+			Tags:           getTagsIn(ctx),
+			ThingGroupName: aws.String(name),
+		}
+
+		if v, ok := d.GetOk("parent_group_name"); ok {
+			input.ParentGroupName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		output, err := conn.CreateThingGroup(ctx, input)
 
-	d.SetId(aws.ToString(output.ThingGroupName))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT Thing Group (%s): %s", name, err)
+		}
+
+		d.SetId(aws.ToString(output.ThingGroupName))
+	}
 
 	return append(diags, resourceThingGroupRead(ctx, d, meta)...)
 }
@@ -172,6 +240,7 @@ func resourceThingGroupRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set(names.AttrARN, output.ThingGroupArn)
+	d.Set("index_name", output.IndexName)
 	d.Set(names.AttrName, output.ThingGroupName)
 
 	if output.ThingGroupMetadata != nil {
@@ -194,6 +263,9 @@ func resourceThingGroupRead(ctx context.Context, d *schema.ResourceData, meta in
 	} else {
 		d.Set("parent_group_name", nil)
 	}
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set(names.AttrStatus, output.Status)
 	d.Set(names.AttrVersion, output.Version)
 
 	return diags
@@ -204,29 +276,58 @@ func resourceThingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).IoTClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
-		input := &iot.UpdateThingGroupInput{
-			ExpectedVersion: aws.Int64(int64(d.Get(names.AttrVersion).(int))),
-			ThingGroupName:  aws.String(d.Get(names.AttrName).(string)),
-		}
-
+		var properties *awstypes.ThingGroupProperties
 		if v, ok := d.GetOk(names.AttrProperties); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.ThingGroupProperties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+			properties = expandThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
 		} else {
-			input.ThingGroupProperties = &awstypes.ThingGroupProperties{}
+			properties = &awstypes.ThingGroupProperties{}
 		}
 
 		// https://docs.aws.amazon.com/iot/latest/apireference/API_AttributePayload.html#API_AttributePayload_Contents:
 		// "To remove an attribute, call UpdateThing with an empty attribute value."
-		if input.ThingGroupProperties.AttributePayload == nil {
-			input.ThingGroupProperties.AttributePayload = &awstypes.AttributePayload{
+		if properties.AttributePayload == nil {
+			properties.AttributePayload = &awstypes.AttributePayload{
 				Attributes: map[string]string{},
 			}
 		}
 
-		_, err := conn.UpdateThingGroup(ctx, input)
+		if v, ok := d.GetOk("query_string"); ok {
+			input := &iot.UpdateDynamicThingGroupInput{
+				ExpectedVersion:      aws.Int64(int64(d.Get(names.AttrVersion).(int))),
+				QueryString:          aws.String(v.(string)),
+				ThingGroupName:       aws.String(d.Id()),
+				ThingGroupProperties: properties,
+			}
+
+			if v, ok := d.GetOk("index_name"); ok {
+				input.IndexName = aws.String(v.(string))
+			}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating IoT Thing Group (%s): %s", d.Id(), err)
+			if v, ok := d.GetOk("query_version"); ok {
+				input.QueryVersion = aws.String(v.(string))
+			}
+
+			_, err := conn.UpdateDynamicThingGroup(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT Dynamic Thing Group (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitDynamicThingGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for IoT Dynamic Thing Group (%s) update: %s", d.Id(), err)
+			}
+		} else {
+			input := &iot.UpdateThingGroupInput{
+				ExpectedVersion:      aws.Int64(int64(d.Get(names.AttrVersion).(int))),
+				ThingGroupName:       aws.String(d.Get(names.AttrName).(string)),
+				ThingGroupProperties: properties,
+			}
+
+			_, err := conn.UpdateThingGroup(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT Thing Group (%s): %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -243,6 +344,12 @@ func resourceThingGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 	)
 	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, timeout,
 		func() (interface{}, error) {
+			if _, ok := d.GetOk("query_string"); ok {
+				return conn.DeleteDynamicThingGroup(ctx, &iot.DeleteDynamicThingGroupInput{
+					ThingGroupName: aws.String(d.Id()),
+				})
+			}
+
 			return conn.DeleteThingGroup(ctx, &iot.DeleteThingGroupInput{
 				ThingGroupName: aws.String(d.Id()),
 			})
@@ -284,6 +391,39 @@ func findThingGroupByName(ctx context.Context, conn *iot.Client, name string) (*
 	return output, nil
 }
 
+func statusDynamicThingGroup(ctx context.Context, conn *iot.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findThingGroupByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDynamicThingGroupActive(ctx context.Context, conn *iot.Client, name string, timeout time.Duration) (*iot.DescribeThingGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DynamicGroupStatusBuilding, awstypes.DynamicGroupStatusRebuilding),
+		Target:  enum.Slice(awstypes.DynamicGroupStatusActive),
+		Refresh: statusDynamicThingGroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iot.DescribeThingGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandThingGroupProperties(tfMap map[string]interface{}) *awstypes.ThingGroupProperties {
 	if tfMap == nil {
 		return nil
@@ -204,6 +204,50 @@ func TestAccIoTThingGroup_properties(t *testing.T) {
 	})
 }
 
+// Dynamic thing groups require fleet indexing, which is a Region-wide setting.
+// The test is run serially with the indexing configuration tests.
+func testAccThingGroup_dynamic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupConfig_dynamic(rName, "attributes.version:1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parent_group_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.version:1"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThingGroupConfig_dynamic(rName, "attributes.version:2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "attributes.version:2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckThingGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -338,3 +382,24 @@ resource "aws_iot_thing_group" "test" {
 }
 `, rName)
 }
+
+func testAccThingGroupConfig_dynamic(rName, queryString string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+
+resource "aws_iot_thing_group" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+
+  properties {
+    description = "dynamic thing group"
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, queryString)
+}
@@ -37,8 +37,10 @@ func resourceClusterPolicy() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cluster_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
 			},
 			"current_version": {
 				Type:     schema.TypeString,
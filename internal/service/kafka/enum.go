@@ -23,3 +23,19 @@ func (publicAccessType) Values() []publicAccessType {
 		publicAccessTypeServiceProvidedEIPs,
 	}
 }
+
+type vpcConnectionAuthenticationType string
+
+const (
+	vpcConnectionAuthenticationTypeSASLIAM   vpcConnectionAuthenticationType = "SASL_IAM"
+	vpcConnectionAuthenticationTypeSASLSCRAM vpcConnectionAuthenticationType = "SASL_SCRAM"
+	vpcConnectionAuthenticationTypeTLS       vpcConnectionAuthenticationType = "TLS"
+)
+
+func (vpcConnectionAuthenticationType) Values() []vpcConnectionAuthenticationType {
+	return []vpcConnectionAuthenticationType{
+		vpcConnectionAuthenticationTypeSASLIAM,
+		vpcConnectionAuthenticationTypeSASLSCRAM,
+		vpcConnectionAuthenticationTypeTLS,
+	}
+}
@@ -57,6 +57,7 @@ func resourceReplicator() *schema.Resource {
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 			},
 			"kafka_cluster": {
 				Type:     schema.TypeList,
@@ -89,6 +90,7 @@ func resourceReplicator() *schema.Resource {
 									"security_groups_ids": {
 										Type:     schema.TypeSet,
 										Optional: true,
+										ForceNew: true,
 										Elem: &schema.Schema{
 											Type: schema.TypeString,
 										},
@@ -125,9 +127,10 @@ func resourceReplicator() *schema.Resource {
 							ValidateFunc: verify.ValidARN,
 						},
 						"target_compression_type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.TargetCompressionType](),
 						},
 						"target_kafka_cluster_alias": {
 							Type:     schema.TypeString,
@@ -230,11 +233,13 @@ func resourceReplicator() *schema.Resource {
 			"replicator_name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"service_execution_role_arn": {
 				Type:         schema.TypeString,
-				ValidateFunc: verify.ValidARN,
 				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
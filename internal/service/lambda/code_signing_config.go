@@ -98,10 +98,36 @@ func resourceCodeSigningConfig() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			resetCodeSigningPoliciesWhenRemoved,
+			customdiff.ComputedIf("last_modified", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("allowed_publishers", names.AttrDescription, "policies")
+			}),
 		),
 	}
 }
 
+// resetCodeSigningPoliciesWhenRemoved plans a return to the default policy when the policies
+// block is removed from configuration, rather than leaving the last applied policy in place.
+func resetCodeSigningPoliciesWhenRemoved(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("policies"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+		return nil
+	}
+
+	if tfList := d.Get("policies").([]interface{}); len(tfList) > 0 && tfList[0] != nil {
+		if tfMap := tfList[0].(map[string]interface{}); awstypes.CodeSigningPolicy(tfMap["untrusted_artifact_on_deployment"].(string)) != awstypes.CodeSigningPolicyWarn {
+			return d.SetNew("policies", []interface{}{map[string]interface{}{
+				"untrusted_artifact_on_deployment": string(awstypes.CodeSigningPolicyWarn),
+			}})
+		}
+	}
+
+	return nil
+}
+
 func resourceCodeSigningConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -165,6 +166,13 @@ func TestAccLambdaCodeSigningConfig_updatePolicy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccCodeSigningConfigConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSigningConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "policies.0.untrusted_artifact_on_deployment", "Warn"),
+				),
+			},
 		},
 	})
 }
@@ -174,7 +182,7 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 	resourceName := "aws_lambda_code_signing_config.code_signing_config"
 	signingProfile1 := "aws_signer_signing_profile.test1"
 	signingProfile2 := "aws_signer_signing_profile.test2"
-	var conf awstypes.CodeSigningConfig
+	var conf1, conf2 awstypes.CodeSigningConfig
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -188,7 +196,7 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 			{
 				Config: testAccCodeSigningConfigConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCodeSigningConfigExists(ctx, resourceName, &conf),
+					testAccCheckCodeSigningConfigExists(ctx, resourceName, &conf1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Code Signing Config for test account"),
 					resource.TestCheckResourceAttr(resourceName, "allowed_publishers.0.signing_profile_version_arns.#", "2"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "allowed_publishers.0.signing_profile_version_arns.*", signingProfile1, "version_arn"),
@@ -198,9 +206,11 @@ func TestAccLambdaCodeSigningConfig_updatePublishers(t *testing.T) {
 			{
 				Config: testAccCodeSigningConfigConfig_updatePublishers(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCodeSigningConfigExists(ctx, resourceName, &conf),
+					testAccCheckCodeSigningConfigExists(ctx, resourceName, &conf2),
+					testAccCheckCodeSigningConfigNotRecreated(&conf1, &conf2),
 					resource.TestCheckResourceAttr(resourceName, "allowed_publishers.0.signing_profile_version_arns.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "allowed_publishers.0.signing_profile_version_arns.*", signingProfile1, "version_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
 				),
 			},
 			{
@@ -233,6 +243,16 @@ func testAccCheckCodeSigningConfigExists(ctx context.Context, n string, v *awsty
 	}
 }
 
+func testAccCheckCodeSigningConfigNotRecreated(i, j *awstypes.CodeSigningConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.CodeSigningConfigArn) != aws.ToString(j.CodeSigningConfigArn) {
+			return fmt.Errorf("Lambda Code Signing Config recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCodeSigningConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
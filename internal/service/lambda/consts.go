@@ -43,3 +43,17 @@ func (lifecycleScope) Values() []lifecycleScope {
 		lifecycleScopeCrud,
 	}
 }
+
+type networkInterfaceCleanupMode string
+
+const (
+	networkInterfaceCleanupModeBestEffort networkInterfaceCleanupMode = "BEST_EFFORT"
+	networkInterfaceCleanupModeWait       networkInterfaceCleanupMode = "WAIT"
+)
+
+func (networkInterfaceCleanupMode) Values() []networkInterfaceCleanupMode {
+	return []networkInterfaceCleanupMode{
+		networkInterfaceCleanupModeBestEffort,
+		networkInterfaceCleanupModeWait,
+	}
+}
@@ -5,6 +5,7 @@ package lambda
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -28,6 +31,7 @@ import (
 	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -35,9 +39,10 @@ import (
 )
 
 const (
-	FunctionVersionLatest = "$LATEST"
-	mutexKey              = `aws_lambda_function`
-	listVersionsMaxItems  = 10000
+	FunctionVersionLatest                         = "$LATEST"
+	mutexKey                                      = `aws_lambda_function`
+	listVersionsMaxItems                          = 10000
+	defaultFunctionUpdatePollingIntervalInSeconds = 5
 )
 
 // @SDKResource("aws_lambda_function", name="Function")
@@ -107,6 +112,26 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"eni_cleanup_on_destroy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          networkInterfaceCleanupModeWait,
+							ValidateDiagFunc: enum.Validate[networkInterfaceCleanupMode](),
+						},
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			names.AttrEnvironment: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -148,6 +173,27 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"event_invoke_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_config": functionEventInvokeConfigDestinationConfigSchema(),
+						"maximum_event_age_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(60, 21600),
+						},
+						"maximum_retry_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(0, 2),
+						},
+					},
+				},
+			},
 			"file_system_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -335,6 +381,10 @@ func resourceFunction() *schema.Resource {
 				Optional:     true,
 				RequiredWith: []string{names.AttrS3Bucket},
 			},
+			"s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -377,6 +427,12 @@ func resourceFunction() *schema.Resource {
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"source_code_s3_object_version_tracking": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"filename", "image_uri", "s3_object_version"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -404,6 +460,12 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"update_polling_interval_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -455,10 +517,16 @@ func resourceFunction() *schema.Resource {
 					return true
 				},
 			},
+			"wait_for_successful_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			trackSourceCodeS3Object,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -604,6 +672,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "awiting for Lambda Function (%s) create: %s", d.Id(), err)
 	}
 
+	// The S3 location wasn't known during plan.
+	if d.Get("source_code_s3_object_version_tracking").(bool) && d.Get("s3_object_etag").(string) == "" {
+		etag, err := findSourceCodeS3ObjectETag(ctx, meta.(*conns.AWSClient), d.Get(names.AttrS3Bucket).(string), d.Get("s3_key").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", d.Id(), err)
+		}
+
+		d.Set("s3_object_etag", etag)
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -615,6 +694,15 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("event_invoke_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandFunctionEventInvokeConfig(v.([]interface{})[0].(map[string]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		if err := putFunctionEventInvokeConfig(ctx, conn, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) event invoke config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -693,6 +781,22 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	} else {
 		d.Set("reserved_concurrent_executions", -1)
 	}
+	// Only refresh the embedded event invoke configuration when managed by this resource,
+	// as it may instead be managed by the aws_lambda_function_event_invoke_config resource.
+	if v, ok := d.GetOk("event_invoke_config"); ok && len(v.([]interface{})) > 0 {
+		output, err := findFunctionEventInvokeConfigByTwoPartKey(ctx, conn, d.Id(), "")
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("event_invoke_config", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) event invoke config: %s", d.Id(), err)
+		default:
+			if err := d.Set("event_invoke_config", flattenFunctionEventInvokeConfig(output)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting event_invoke_config: %s", err)
+			}
+		}
+	}
 	d.Set(names.AttrRole, function.Role)
 	d.Set("runtime", function.Runtime)
 	d.Set("signing_job_arn", function.SigningJobArn)
@@ -928,8 +1032,11 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) configuration: %s", d.Id(), err)
 		}
 
-		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) configuration update: %s", d.Id(), err)
+		// A subsequent code update or version publish requires the configuration update to have completed.
+		if waitForSuccessfulFunctionUpdate(d) || needsFunctionCodeUpdate(d) || d.Get("publish").(bool) {
+			if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), functionUpdatePollInterval(d)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) configuration update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -976,8 +1083,8 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		if err != nil {
 			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "Error occurred while GetObject.") {
-				// As s3_bucket, s3_key and s3_object_version aren't set in resourceFunctionRead(), don't ovewrite the last known good values.
-				for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version"} {
+				// As s3_bucket, s3_key, s3_object_etag and s3_object_version aren't set in resourceFunctionRead(), don't ovewrite the last known good values.
+				for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_etag", "s3_object_version"} {
 					old, _ := d.GetChange(key)
 					d.Set(key, old)
 				}
@@ -986,8 +1093,21 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
 
-		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: waiting for completion: %s", d.Id(), err)
+		if waitForSuccessfulFunctionUpdate(d) || d.Get("publish").(bool) {
+			if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), functionUpdatePollInterval(d)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: waiting for completion: %s", d.Id(), err)
+			}
+		}
+
+		// The S3 location wasn't known during plan.
+		if d.Get("source_code_s3_object_version_tracking").(bool) && d.Get("s3_object_etag").(string) == "" {
+			etag, err := findSourceCodeS3ObjectETag(ctx, meta.(*conns.AWSClient), d.Get(names.AttrS3Bucket).(string), d.Get("s3_key").(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
+
+			d.Set("s3_object_etag", etag)
 		}
 	}
 
@@ -1012,6 +1132,25 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("event_invoke_config") {
+		if v, ok := d.GetOk("event_invoke_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := expandFunctionEventInvokeConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.FunctionName = aws.String(d.Id())
+
+			if err := putFunctionEventInvokeConfig(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) event invoke config: %s", d.Id(), err)
+			}
+		} else {
+			_, err := conn.DeleteFunctionEventInvokeConfig(ctx, &lambda.DeleteFunctionEventInvokeConfigInput{
+				FunctionName: aws.String(d.Id()),
+			})
+
+			if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s) event invoke config: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1027,13 +1166,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		output := outputRaw.(*lambda.PublishVersionOutput)
 
-		err = lambda.NewFunctionUpdatedWaiter(conn).Wait(ctx, &lambda.GetFunctionConfigurationInput{
-			FunctionName: output.FunctionArn,
-			Qualifier:    output.Version,
-		}, d.Timeout(schema.TimeoutUpdate))
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
+		if waitForSuccessfulFunctionUpdate(d) {
+			if _, err := waitFunctionVersionUpdated(ctx, conn, aws.ToString(output.FunctionArn), aws.ToString(output.Version), d.Timeout(schema.TimeoutUpdate), functionUpdatePollInterval(d)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -1070,6 +1206,13 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s): %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("eni_cleanup_on_destroy"); ok && len(v.([]interface{})) > 0 {
+		// The function has been deleted, so failing to clean up its network interfaces is not an error.
+		if err := cleanupNetworkInterfacesOnDestroy(ctx, d, meta); err != nil {
+			return sdkdiag.AppendWarningf(diags, "cleaning up Lambda Function (%s) network interfaces: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -1102,6 +1245,34 @@ func findFunction(ctx context.Context, conn *lambda.Client, input *lambda.GetFun
 	return output, nil
 }
 
+func findFunctionConfigurationByTwoPartKey(ctx context.Context, conn *lambda.Client, name, qualifier string) (*lambda.GetFunctionConfigurationOutput, error) {
+	input := &lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, name string) (*awstypes.FunctionConfiguration, error) {
 	input := &lambda.ListVersionsByFunctionInput{
 		FunctionName: aws.String(name),
@@ -1184,16 +1355,104 @@ func replaceSecurityGroupsOnDestroy(ctx context.Context, d *schema.ResourceData,
 		return fmt.Errorf("updating Lambda Function (%s) configuration: %s", d.Id(), err)
 	}
 
-	if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete), functionUpdatePollInterval(d)); err != nil {
 		return fmt.Errorf("waiting for Lambda Function (%s) configuration update: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func statusFunctionLastUpdateStatus(ctx context.Context, conn *lambda.Client, name string) retry.StateRefreshFunc {
+// cleanupNetworkInterfacesOnDestroy removes the Hyperplane ENIs created for a deleted function
+//
+// This function is called when the eni_cleanup_on_destroy argument is set.
+// Rather than leaving the Lambda service to delete the ENIs in the background,
+// which can take 40 minutes or more and blocks the destruction of the
+// function's subnets and security groups, any ENIs that the function created in
+// its subnets with the configured (or the function's) security groups are
+// detached and deleted as soon as the Lambda service releases them.
+//
+// In BEST_EFFORT mode only ENIs that have already been released are deleted.
+func cleanupNetworkInterfacesOnDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	var sgIDs, subnetIDs []string
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		sgIDs = flex.ExpandStringValueSet(tfMap[names.AttrSecurityGroupIDs].(*schema.Set))
+		subnetIDs = flex.ExpandStringValueSet(tfMap[names.AttrSubnetIDs].(*schema.Set))
+	}
+
+	mode := networkInterfaceCleanupModeWait
+	if v := d.Get("eni_cleanup_on_destroy").([]interface{}); len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		mode = networkInterfaceCleanupMode(tfMap[names.AttrMode].(string))
+		if v := flex.ExpandStringValueSet(tfMap[names.AttrSecurityGroupIDs].(*schema.Set)); len(v) > 0 {
+			sgIDs = v
+		}
+	}
+
+	if len(sgIDs) == 0 || len(subnetIDs) == 0 { // not VPC-attached, nothing to do
+		return nil
+	}
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter(names.AttrDescription, []string{fmt.Sprintf("AWS Lambda VPC ENI-%s*", d.Id())}),
+			tfec2.NewFilter("group-id", sgIDs),
+			tfec2.NewFilter("subnet-id", subnetIDs),
+		},
+	}
+
+	networkInterfaces, err := tfec2.FindNetworkInterfaces(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+	}
+
+	var errs []error
+	for _, v := range networkInterfaces {
+		networkInterfaceID := aws.ToString(v.NetworkInterfaceId)
+
+		if v.Status == ec2types.NetworkInterfaceStatusInUse {
+			if mode == networkInterfaceCleanupModeBestEffort {
+				log.Printf("[DEBUG] Skipping in-use Lambda Function (%s) network interface: %s", d.Id(), networkInterfaceID)
+				continue
+			}
+
+			networkInterface, err := tfec2.WaitNetworkInterfaceAvailableAfterUse(ctx, conn, networkInterfaceID, d.Timeout(schema.TimeoutDelete))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("waiting for EC2 Network Interface (%s) release: %w", networkInterfaceID, err))
+				continue
+			}
+
+			v = *networkInterface
+		}
+
+		if v.Attachment != nil {
+			if err := tfec2.DetachNetworkInterface(ctx, conn, networkInterfaceID, aws.ToString(v.Attachment.AttachmentId), tfec2.NetworkInterfaceDetachedTimeout); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		if err := tfec2.DeleteNetworkInterface(ctx, conn, networkInterfaceID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// statusFunctionUpdate reports an update as still in progress while the function is pending,
+// as container image functions can return to the Pending state after the update has succeeded.
+func statusFunctionUpdate(ctx context.Context, conn *lambda.Client, name, qualifier string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findFunctionByName(ctx, conn, name)
+		output, err := findFunctionConfigurationByTwoPartKey(ctx, conn, name, qualifier)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -1203,7 +1462,11 @@ func statusFunctionLastUpdateStatus(ctx context.Context, conn *lambda.Client, na
 			return nil, "", err
 		}
 
-		return output.Configuration, string(output.Configuration.LastUpdateStatus), nil
+		if output.LastUpdateStatus == awstypes.LastUpdateStatusSuccessful && output.State == awstypes.StatePending {
+			return output, string(awstypes.LastUpdateStatusInProgress), nil
+		}
+
+		return output, string(output.LastUpdateStatus), nil
 	}
 }
 
@@ -1243,18 +1506,24 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string,
 	return nil, err
 }
 
-func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName string, timeout time.Duration) (*awstypes.FunctionConfiguration, error) { //nolint:unparam
+func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName string, timeout, pollInterval time.Duration) (*lambda.GetFunctionConfigurationOutput, error) {
+	return waitFunctionVersionUpdated(ctx, conn, functionName, "", timeout, pollInterval)
+}
+
+func waitFunctionVersionUpdated(ctx context.Context, conn *lambda.Client, functionName, qualifier string, timeout, pollInterval time.Duration) (*lambda.GetFunctionConfigurationOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.LastUpdateStatusInProgress),
-		Target:  enum.Slice(awstypes.LastUpdateStatusSuccessful),
-		Refresh: statusFunctionLastUpdateStatus(ctx, conn, functionName),
-		Timeout: timeout,
-		Delay:   5 * time.Second,
+		Pending:                   enum.Slice(awstypes.LastUpdateStatusInProgress),
+		Target:                    enum.Slice(awstypes.LastUpdateStatusSuccessful),
+		Refresh:                   statusFunctionUpdate(ctx, conn, functionName, qualifier),
+		Timeout:                   timeout,
+		Delay:                     pollInterval,
+		PollInterval:              pollInterval,
+		ContinuousTargetOccurence: 2,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*awstypes.FunctionConfiguration); ok {
+	if output, ok := outputRaw.(*lambda.GetFunctionConfigurationOutput); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastUpdateStatusReasonCode), aws.ToString(output.LastUpdateStatusReason)))
 
 		return output, err
@@ -1263,6 +1532,30 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	return nil, err
 }
 
+// functionUpdatePollInterval and waitForSuccessfulFunctionUpdate resolve defaults in code rather than
+// via schema defaults so that functions created before the arguments were added don't show a diff.
+// The values are read from configuration as those in state aren't refreshed and outlive their removal from configuration.
+func functionUpdatePollInterval(d *schema.ResourceData) time.Duration {
+	seconds := int64(defaultFunctionUpdatePollingIntervalInSeconds)
+	if rawConfig := d.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("update_polling_interval_in_seconds"); v.IsKnown() && !v.IsNull() {
+			seconds, _ = v.AsBigFloat().Int64()
+		}
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func waitForSuccessfulFunctionUpdate(d *schema.ResourceData) bool {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("wait_for_successful_update"); v.IsKnown() && !v.IsNull() {
+			return v.True()
+		}
+	}
+
+	return true
+}
+
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
 type functionCU interface {
@@ -1334,6 +1627,44 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// trackSourceCodeS3Object plans a code update when the S3 object containing the function's deployment package
+// has changed since it was last deployed, even if the configured S3 location has not.
+func trackSourceCodeS3Object(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("source_code_s3_object_version_tracking").(bool) {
+		if v, _ := d.GetChange("s3_object_etag"); v.(string) != "" {
+			return d.SetNew("s3_object_etag", "")
+		}
+
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrS3Bucket) || !d.NewValueKnown("s3_key") {
+		return d.SetNewComputed("s3_object_etag")
+	}
+
+	etag, err := findSourceCodeS3ObjectETag(ctx, meta.(*conns.AWSClient), d.Get(names.AttrS3Bucket).(string), d.Get("s3_key").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("s3_object_etag").(string) != etag {
+		return d.SetNew("s3_object_etag", etag)
+	}
+
+	return nil
+}
+
+func findSourceCodeS3ObjectETag(ctx context.Context, c *conns.AWSClient, bucket, key string) (string, error) {
+	output, err := tfs3.FindObjectByBucketAndKey(ctx, c.S3Client(ctx), bucket, key, "", "")
+
+	if err != nil {
+		return "", fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+	}
+
+	return aws.ToString(output.ETag), nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1357,6 +1688,8 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
+		// The ETag is cleared without a code update when S3 object version tracking is turned off.
+		(d.HasChange("s3_object_etag") && d.Get("s3_object_etag").(string) != "") ||
 		d.HasChange("image_uri") ||
 		d.HasChange("architectures")
 }
@@ -38,43 +38,7 @@ func resourceFunctionEventInvokeConfig() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"destination_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"on_failure": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrDestination: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-						},
-						"on_success": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrDestination: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"destination_config": functionEventInvokeConfigDestinationConfigSchema(),
 			"function_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -126,27 +90,7 @@ func resourceFunctionEventInvokeConfigCreate(ctx context.Context, d *schema.Reso
 		input.MaximumEventAgeInSeconds = aws.Int32(int32(v.(int)))
 	}
 
-	// Retry for destination validation eventual consistency errors.
-	_, err := tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
-			return conn.PutFunctionEventInvokeConfig(ctx, input)
-		},
-		func(err error) (bool, error) {
-			// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") {
-				return true, err
-			}
-
-			// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions") {
-				return true, err
-			}
-
-			return false, err
-		},
-	)
-
-	if err != nil {
+	if err := putFunctionEventInvokeConfig(ctx, conn, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function Event Invoke Config (%s): %s", id, err)
 	}
 
@@ -210,27 +154,7 @@ func resourceFunctionEventInvokeConfigUpdate(ctx context.Context, d *schema.Reso
 		input.MaximumEventAgeInSeconds = aws.Int32(int32(v.(int)))
 	}
 
-	// Retry for destination validation eventual consistency errors.
-	_, err = tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
-			return conn.PutFunctionEventInvokeConfig(ctx, input)
-		},
-		func(err error) (bool, error) {
-			// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") {
-				return true, err
-			}
-
-			// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
-			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions") {
-				return true, err
-			}
-
-			return false, err
-		},
-	)
-
-	if err != nil {
+	if err := putFunctionEventInvokeConfig(ctx, conn, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lambda Function Event Invoke Config (%s): %s", d.Id(), err)
 	}
 
@@ -268,6 +192,70 @@ func resourceFunctionEventInvokeConfigDelete(ctx context.Context, d *schema.Reso
 	return diags
 }
 
+func functionEventInvokeConfigDestinationConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on_failure": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrDestination: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"on_success": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrDestination: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func putFunctionEventInvokeConfig(ctx context.Context, conn *lambda.Client, input *lambda.PutFunctionEventInvokeConfigInput) error {
+	// Retry for destination validation eventual consistency errors.
+	_, err := tfresource.RetryWhen(ctx, iamPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.PutFunctionEventInvokeConfig(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// InvalidParameterValueException: The destination ARN arn:PARTITION:SERVICE:REGION:ACCOUNT:RESOURCE is invalid.
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "destination ARN") {
+				return true, err
+			}
+
+			// InvalidParameterValueException: The function's execution role does not have permissions to call Publish on arn:...
+			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "does not have permissions") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
 func functionEventInvokeConfigParseResourceID(id string) (string, string, error) {
 	if arn.IsARN(id) {
 		parsedARN, err := arn.Parse(id)
@@ -393,6 +381,38 @@ func expandFunctionEventInvokeConfigDestinationConfigOnSuccess(tfList []interfac
 	return onSuccess
 }
 
+func expandFunctionEventInvokeConfig(tfMap map[string]interface{}) *lambda.PutFunctionEventInvokeConfigInput {
+	apiObject := &lambda.PutFunctionEventInvokeConfigInput{}
+
+	if v, ok := tfMap["destination_config"].([]interface{}); ok {
+		apiObject.DestinationConfig = expandFunctionEventInvokeConfigDestinationConfig(v)
+	}
+
+	if v, ok := tfMap["maximum_event_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumEventAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenFunctionEventInvokeConfig(apiObject *lambda.GetFunctionEventInvokeConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_config":           flattenFunctionEventInvokeConfigDestinationConfig(apiObject.DestinationConfig),
+		"maximum_event_age_in_seconds": aws.ToInt32(apiObject.MaximumEventAgeInSeconds),
+		"maximum_retry_attempts":       aws.ToInt32(apiObject.MaximumRetryAttempts),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenFunctionEventInvokeConfigDestinationConfig(apiObject *awstypes.DestinationConfig) []interface{} {
 	// The API will respond with empty OnFailure and OnSuccess destinations when unconfigured:
 	// "DestinationConfig":{"OnFailure":{"Destination":null},"OnSuccess":{"Destination":null}}
@@ -5,6 +5,7 @@ package lambda_test
 
 import (
 	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	signertypes "github.com/aws/aws-sdk-go-v2/service/signer/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -92,6 +94,85 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_waitForSuccessfulUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_waitForSuccessfulUpdate(rName, 128, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "memory_size", "128"),
+					resource.TestCheckResourceAttr(resourceName, "update_polling_interval_in_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_successful_update", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_waitForSuccessfulUpdate(rName, 256, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "memory_size", "256"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_waitForSuccessfulUpdate(rName, 512, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "memory_size", "512"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_successful_update", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_waitForSuccessfulUpdateUpgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_upgrade_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_upgrade_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_upgrade_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_upgrade_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.LambdaServiceID),
+		CheckDestroy: testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.75.1",
+					},
+				},
+				Config: testAccFunctionConfig_basic(funcName, policyName, roleName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+				),
+			},
+			{
+				// Functions created before wait_for_successful_update and update_polling_interval_in_seconds were added should plan empty
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccFunctionConfig_basic(funcName, policyName, roleName, sgName),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var function lambda.GetFunctionOutput
@@ -321,6 +402,50 @@ func TestAccLambdaFunction_concurrencyCycle(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_eventInvokeConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_eventInvokeConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.0.destination_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.0.destination_config.0.on_failure.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_invoke_config.0.destination_config.0.on_failure.0.destination", snsTopicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.0.maximum_event_age_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.0.maximum_retry_attempts", "1"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_eventInvokeConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.0.maximum_retry_attempts", "0"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_basicConcurrency(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "event_invoke_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_expectFilenameAndS3Attributes(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1749,6 +1874,74 @@ func TestAccLambdaFunction_VPC_replaceSGWithCustom(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_VPC_eniCleanupOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var function lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_vpcENICleanupOnDestroy(rName, "WAIT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &function),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.0.mode", "WAIT"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.0.security_group_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_vpcENICleanupOnDestroy(rName, "BEST_EFFORT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &function),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.0.mode", "BEST_EFFORT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_VPC_eniCleanupOnDestroySecurityGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var function lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_vpcENICleanupOnDestroySecurityGroups(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &function),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.0.mode", "WAIT"),
+					resource.TestCheckResourceAttr(resourceName, "eni_cleanup_on_destroy.0.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "eni_cleanup_on_destroy.0.security_group_ids.*", "aws_security_group.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_emptyVPC(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionOutput
@@ -2043,6 +2236,119 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_S3Update_objectVersionTracking(t *testing.T) {
+	ctx := acctest.Context(t)
+	path, zipFile, err := createTempFile("lambda_s3Update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	key := "lambda-func.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					// Upload 1st version
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3ObjectVersionTracking(rName, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "MbW0T1Pcy1QPtrFC9dT7hUfircj1NXss2uXgakqzAbk="),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_object_etag"),
+					resource.TestCheckResourceAttr(resourceName, "source_code_s3_object_version_tracking", acctest.CtTrue),
+				),
+			},
+			{
+				PreConfig: func() {
+					// Upload 2nd version outside of Terraform, as a CI pipeline would.
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+
+					body, err := os.ReadFile(path)
+					if err != nil {
+						t.Fatalf("error reading zip file: %s", err)
+					}
+
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+					_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+						Body:   bytes.NewReader(body),
+						Bucket: aws.String(rName),
+						Key:    aws.String(key),
+					})
+					if err != nil {
+						t.Fatalf("error uploading S3 object: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3ObjectVersionTracking(rName, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "7qn3LZOWCpWK5nm49qjw+VrbPQHfdu2ZrDjBsSUveKM="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_S3Update_objectVersionTrackingDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	path, zipFile, err := createTempFile("lambda_s3Update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	key := "lambda-func.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3ObjectVersionTrackingPublish(rName, key, path, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_object_etag"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				// Turning tracking off clears the recorded ETag without updating the code or publishing a new version.
+				Config: testAccFunctionConfig_s3ObjectVersionTrackingPublish(rName, key, path, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "MbW0T1Pcy1QPtrFC9dT7hUfircj1NXss2uXgakqzAbk="),
+					resource.TestCheckResourceAttr(resourceName, "s3_object_etag", ""),
+					resource.TestCheckResourceAttr(resourceName, "source_code_s3_object_version_tracking", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_snapStart(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionOutput
@@ -2742,6 +3048,35 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_eventInvokeConfig(rName string, maximumRetryAttempts int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  event_invoke_config {
+    maximum_event_age_in_seconds = 300
+    maximum_retry_attempts       = %[2]d
+
+    destination_config {
+      on_failure {
+        destination = aws_sns_topic.test.arn
+      }
+    }
+  }
+}
+`, rName, maximumRetryAttempts))
+}
+
 func testAccFunctionConfig_noFilenameAndS3Attributes(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3403,6 +3738,25 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_waitForSuccessfulUpdate(rName string, memorySize int, waitForSuccessfulUpdate bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  memory_size   = %[2]d
+  publish       = true
+
+  update_polling_interval_in_seconds = 10
+  wait_for_successful_update         = %[3]t
+}
+`, rName, memorySize, waitForSuccessfulUpdate))
+}
+
 func testAccFunctionConfig_tracingUpdated(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3674,6 +4028,63 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_vpcENICleanupOnDestroy(rName, mode string) string {
+	return acctest.ConfigCompose(
+		testAccFunctionConfigBase_properIAMDependencies(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  eni_cleanup_on_destroy {
+    mode = %[2]q
+  }
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`, rName, mode))
+}
+
+func testAccFunctionConfig_vpcENICleanupOnDestroySecurityGroups(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFunctionConfigBase_properIAMDependencies(rName),
+		fmt.Sprintf(`
+resource "aws_security_group" "test2" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  name   = "%[1]s-2"
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  eni_cleanup_on_destroy {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id, aws_security_group.test2.id]
+  }
+}
+`, rName))
+}
+
 func testAccFunctionConfig_emptyVPC(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3857,6 +4268,76 @@ resource "aws_lambda_function" "test" {
 `, key, path, rName)
 }
 
+func testAccFunctionConfig_s3ObjectVersionTrackingBase(rName, key, path string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "artifacts" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "o" {
+  bucket = aws_s3_bucket.artifacts.bucket
+  key    = %[2]q
+  source = %[3]q
+
+  lifecycle {
+    ignore_changes = [source]
+  }
+}
+
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+`, rName, key, path)
+}
+
+func testAccFunctionConfig_s3ObjectVersionTracking(rName, key, path string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_s3ObjectVersionTrackingBase(rName, key, path), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  s3_bucket     = aws_s3_object.o.bucket
+  s3_key        = aws_s3_object.o.key
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  source_code_s3_object_version_tracking = true
+}
+`, rName))
+}
+
+func testAccFunctionConfig_s3ObjectVersionTrackingPublish(rName, key, path string, tracking bool) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_s3ObjectVersionTrackingBase(rName, key, path), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  s3_bucket     = aws_s3_object.o.bucket
+  s3_key        = aws_s3_object.o.key
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  publish       = true
+
+  source_code_s3_object_version_tracking = %[2]t
+}
+`, rName, tracking))
+}
+
 func testAccFunctionConfig_s3UnversionedTPL(rName, key, path string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "artifacts" {
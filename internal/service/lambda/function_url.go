@@ -97,6 +97,10 @@ func resourceFunctionURL() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: suppressEquivalentFunctionNameOrARN,
 			},
+			"function_qualified_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"function_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -209,6 +213,7 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.Set(names.AttrFunctionARN, output.FunctionArn)
 	d.Set("function_name", name)
+	d.Set("function_qualified_arn", functionURLQualifiedARN(aws.ToString(output.FunctionArn), qualifier))
 	d.Set("function_url", functionURL)
 	d.Set("invoke_mode", output.InvokeMode)
 	d.Set("qualifier", qualifier)
@@ -357,6 +362,16 @@ func functionURLParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", id, functionURLResourceIDSeparator)
 }
 
+// functionURLQualifiedARN returns the ARN of the function, including any qualifier, that the function URL invokes.
+// The function ARN returned by the API may already include the qualifier.
+func functionURLQualifiedARN(functionARN, qualifier string) string {
+	if qualifier == "" || strings.HasSuffix(functionARN, ":"+qualifier) {
+		return functionARN
+	}
+
+	return functionARN + ":" + qualifier
+}
+
 func expandCors(tfMap map[string]interface{}) *awstypes.Cors {
 	if tfMap == nil {
 		return nil
@@ -74,6 +74,10 @@ func dataSourceFunctionURL() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"function_qualified_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"function_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -124,6 +128,7 @@ func dataSourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrCreationTime, output.CreationTime)
 	d.Set(names.AttrFunctionARN, output.FunctionArn)
 	d.Set("function_name", name)
+	d.Set("function_qualified_arn", functionURLQualifiedARN(aws.ToString(output.FunctionArn), qualifier))
 	d.Set("function_url", functionURL)
 	d.Set("invoke_mode", output.InvokeMode)
 	d.Set("last_modified_time", output.LastModifiedTime)
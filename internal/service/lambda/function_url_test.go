@@ -48,6 +48,7 @@ func TestAccLambdaFunctionURL_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrFunctionARN),
 					resource.TestCheckResourceAttr(resourceName, "function_name", funcName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_qualified_arn", resourceName, names.AttrFunctionARN),
 					resource.TestCheckResourceAttrSet(resourceName, "function_url"),
 					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
 					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
@@ -204,6 +205,7 @@ func TestAccLambdaFunctionURL_TwoURLs(t *testing.T) {
 					resource.TestCheckResourceAttr(liveResourceName, "cors.#", "0"),
 					resource.TestCheckResourceAttrSet(liveResourceName, names.AttrFunctionARN),
 					resource.TestCheckResourceAttr(liveResourceName, "function_name", funcName),
+					resource.TestCheckResourceAttrPair(liveResourceName, "function_qualified_arn", "aws_lambda_alias.live", names.AttrARN),
 					resource.TestCheckResourceAttrSet(liveResourceName, "function_url"),
 					resource.TestCheckResourceAttr(liveResourceName, "qualifier", "live"),
 					resource.TestCheckResourceAttrSet(liveResourceName, "url_id"),
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/location"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/location/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_key", name="Key")
+// @Tags(identifierAttribute="key_arn")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"no_expiry"},
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"key_value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"no_expiry": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"expire_time"},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(5, 200),
+							},
+						},
+						"allow_referers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 253),
+							},
+						},
+						"allow_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 1600),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationClient(ctx)
+
+	name := d.Get("key_name").(string)
+	input := &location.CreateKeyInput{
+		KeyName: aws.String(name),
+		Tags:    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		input.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Restrictions = expandAPIKeyRestrictions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateKey(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Location Service Key (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.KeyName))
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationClient(ctx)
+
+	input := &location.DescribeKeyInput{
+		KeyName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeKey(ctx, input)
+
+	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		log.Printf("[WARN] Location Service Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Location Service Key (%s): empty response", d.Id())
+	}
+
+	d.Set(names.AttrCreateTime, aws.ToTime(output.CreateTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("expire_time", aws.ToTime(output.ExpireTime).Format(time.RFC3339))
+	d.Set("key_arn", output.KeyArn)
+	d.Set("key_name", output.KeyName)
+	d.Set("key_value", output.Key)
+	if output.Restrictions != nil {
+		d.Set("restrictions", []interface{}{flattenAPIKeyRestrictions(output.Restrictions)})
+	} else {
+		d.Set("restrictions", nil)
+	}
+	d.Set("update_time", aws.ToTime(output.UpdateTime).Format(time.RFC3339))
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, "expire_time", "no_expiry", "restrictions") {
+		input := &location.UpdateKeyInput{
+			KeyName: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if v, ok := d.GetOk("no_expiry"); ok && v.(bool) {
+				input.NoExpiry = aws.Bool(true)
+			} else if v, ok := d.GetOk("expire_time"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+				input.ExpireTime = aws.Time(v)
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			if v, ok := d.GetOk("restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Restrictions = expandAPIKeyRestrictions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		// Keys that have been used in the past 7 days can only be updated with ForceUpdate.
+		if input.ExpireTime != nil || input.NoExpiry != nil || input.Restrictions != nil {
+			input.ForceUpdate = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateKey(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Location Service Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LocationClient(ctx)
+
+	// Without ForceDelete, only keys that have been inactive for at least 90 days can be deleted.
+	input := &location.DeleteKeyInput{
+		ForceDelete: aws.Bool(true),
+		KeyName:     aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteKey(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Location Service Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandAPIKeyRestrictions(tfMap map[string]interface{}) *awstypes.ApiKeyRestrictions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ApiKeyRestrictions{}
+
+	if v, ok := tfMap["allow_actions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowActions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allow_referers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowReferers = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["allow_resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowResources = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *awstypes.ApiKeyRestrictions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllowActions; v != nil {
+		tfMap["allow_actions"] = flex.FlattenStringValueSet(v)
+	}
+
+	if v := apiObject.AllowReferers; v != nil {
+		tfMap["allow_referers"] = flex.FlattenStringValueSet(v)
+	}
+
+	if v := apiObject.AllowResources; v != nil {
+		tfMap["allow_resources"] = flex.FlattenStringValueSet(v)
+	}
+
+	return tfMap
+}
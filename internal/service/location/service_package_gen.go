@@ -65,6 +65,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "collection_arn",
 			},
 		},
+		{
+			Factory:  ResourceKey,
+			TypeName: "aws_location_key",
+			Name:     "Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "key_arn",
+			},
+		},
 		{
 			Factory:  ResourceMap,
 			TypeName: "aws_location_map",
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_addon_instance", name="Addon Instance")
+// @Tags(identifierAttribute="arn")
+func newAddonInstanceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &addonInstanceResource{}, nil
+}
+
+type addonInstanceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[addonInstanceResourceModel]
+	framework.WithImportByID
+}
+
+func (*addonInstanceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_addon_instance"
+}
+
+func (r *addonInstanceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"addon_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addon_subscription_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *addonInstanceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data addonInstanceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	subscriptionID := data.AddonSubscriptionID.ValueString()
+	input := &mailmanager.CreateAddonInstanceInput{
+		AddonSubscriptionId: aws.String(subscriptionID),
+		ClientToken:         aws.String(sdkid.UniqueId()),
+		Tags:                getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateAddonInstance(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SES Mail Manager Addon Instance (%s)", subscriptionID), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.AddonInstanceId)
+
+	instance, err := findAddonInstanceByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Addon Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AddonInstanceARN = fwflex.StringToFramework(ctx, instance.AddonInstanceArn)
+	data.AddonName = fwflex.StringToFramework(ctx, instance.AddonName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *addonInstanceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data addonInstanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findAddonInstanceByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Addon Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *addonInstanceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data addonInstanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	_, err := conn.DeleteAddonInstance(ctx, &mailmanager.DeleteAddonInstanceInput{
+		AddonInstanceId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SES Mail Manager Addon Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *addonInstanceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAddonInstanceByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetAddonInstanceOutput, error) {
+	input := &mailmanager.GetAddonInstanceInput{
+		AddonInstanceId: aws.String(id),
+	}
+
+	output, err := conn.GetAddonInstance(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type addonInstanceResourceModel struct {
+	AddonInstanceARN    types.String `tfsdk:"arn"`
+	AddonName           types.String `tfsdk:"addon_name"`
+	AddonSubscriptionID types.String `tfsdk:"addon_subscription_id"`
+	ID                  types.String `tfsdk:"id"`
+	Tags                tftags.Map   `tfsdk:"tags"`
+	TagsAll             tftags.Map   `tfsdk:"tags_all"`
+}
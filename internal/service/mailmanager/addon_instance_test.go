@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerAddonInstance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_addon_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonInstanceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAddonInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addon_name", "SPAMHAUS_DBL"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "addon_subscription_id", "aws_mailmanager_addon_subscription.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerAddonInstance_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_addon_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonInstanceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAddonInstanceExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceAddonInstance, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAddonInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_addon_instance" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindAddonInstanceByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SES Mail Manager Addon Instance %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAddonInstanceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		_, err := tfmailmanager.FindAddonInstanceByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+const testAccAddonInstanceConfig_basic = `
+resource "aws_mailmanager_addon_subscription" "test" {
+  addon_name = "SPAMHAUS_DBL"
+}
+
+resource "aws_mailmanager_addon_instance" "test" {
+  addon_subscription_id = aws_mailmanager_addon_subscription.test.id
+}
+`
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_archive", name="Archive")
+// @Tags(identifierAttribute="arn")
+func newArchiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &archiveResource{}, nil
+}
+
+type archiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*archiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_archive"
+}
+
+func (r *archiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"archive_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*[a-zA-Z0-9]$`), ""),
+				},
+			},
+			"archive_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ArchiveState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"retention": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[archiveRetentionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"retention_period": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RetentionPeriod](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *archiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	input := mailmanager.CreateArchiveInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateArchive(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SES Mail Manager Archive (%s)", data.ArchiveName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.ArchiveId)
+
+	archive, err := findArchiveByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Archive (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ArchiveARN = fwflex.StringToFramework(ctx, archive.ArchiveArn)
+	data.ArchiveState = fwtypes.StringEnumValue(archive.ArchiveState)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *archiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findArchiveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Archive (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *archiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	if !new.ArchiveName.Equal(old.ArchiveName) || !new.Retention.Equal(old.Retention) {
+		input := mailmanager.UpdateArchiveInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.ArchiveId = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateArchive(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SES Mail Manager Archive (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *archiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	_, err := conn.DeleteArchive(ctx, &mailmanager.DeleteArchiveInput{
+		ArchiveId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SES Mail Manager Archive (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *archiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findArchiveByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetArchiveOutput, error) {
+	input := &mailmanager.GetArchiveInput{
+		ArchiveId: aws.String(id),
+	}
+
+	output, err := conn.GetArchive(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted archives are retained in a pending deletion state.
+	if output.ArchiveState == awstypes.ArchiveStatePendingDeletion {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.ArchiveState),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type archiveResourceModel struct {
+	ArchiveARN   types.String                                           `tfsdk:"arn"`
+	ArchiveName  types.String                                           `tfsdk:"archive_name"`
+	ArchiveState fwtypes.StringEnum[awstypes.ArchiveState]              `tfsdk:"archive_state"`
+	ID           types.String                                           `tfsdk:"id"`
+	KMSKeyARN    fwtypes.ARN                                            `tfsdk:"kms_key_arn"`
+	Retention    fwtypes.ListNestedObjectValueOf[archiveRetentionModel] `tfsdk:"retention"`
+	Tags         tftags.Map                                             `tfsdk:"tags"`
+	TagsAll      tftags.Map                                             `tfsdk:"tags_all"`
+}
+
+type archiveRetentionModel struct {
+	RetentionPeriod fwtypes.StringEnum[awstypes.RetentionPeriod] `tfsdk:"retention_period"`
+}
+
+var (
+	_ fwflex.Expander  = archiveRetentionModel{}
+	_ fwflex.Flattener = &archiveRetentionModel{}
+)
+
+func (m archiveRetentionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.RetentionPeriod.IsNull():
+		result = &awstypes.ArchiveRetentionMemberRetentionPeriod{
+			Value: m.RetentionPeriod.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *archiveRetentionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ArchiveRetentionMemberRetentionPeriod:
+		m.RetentionPeriod = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
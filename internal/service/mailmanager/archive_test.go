@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerArchive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_archive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_basic(rName, "THREE_MONTHS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckArchiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "archive_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "archive_state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "retention.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention.0.retention_period", "THREE_MONTHS"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArchiveConfig_basic(rName, "ONE_YEAR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckArchiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention.0.retention_period", "ONE_YEAR"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerArchive_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_archive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_basic(rName, "THREE_MONTHS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckArchiveExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceArchive, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckArchiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_archive" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindArchiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SES Mail Manager Archive %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckArchiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		_, err := tfmailmanager.FindArchiveByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccArchiveConfig_basic(rName, retentionPeriod string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+
+  retention {
+    retention_period = %[2]q
+  }
+}
+`, rName, retentionPeriod)
+}
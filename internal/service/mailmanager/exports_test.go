@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+// Exports for use in tests only.
+var (
+	ResourceAddonInstance     = newAddonInstanceResource
+	ResourceAddonSubscription = newAddonSubscriptionResource
+	ResourceArchive           = newArchiveResource
+	ResourceIngressPoint      = newIngressPointResource
+	ResourceRuleSet           = newRuleSetResource
+	ResourceTrafficPolicy     = newTrafficPolicyResource
+
+	FindAddonInstanceByID     = findAddonInstanceByID
+	FindAddonSubscriptionByID = findAddonSubscriptionByID
+	FindArchiveByID           = findArchiveByID
+	FindIngressPointByID      = findIngressPointByID
+	FindRuleSetByID           = findRuleSetByID
+	FindTrafficPolicyByID     = findTrafficPolicyByID
+)
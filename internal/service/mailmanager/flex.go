@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// expandUnionMember expands the single element of a union member block into the member's Value.
+func expandUnionMember[T, V any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], apiObject *V) (diags diag.Diagnostics) {
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Expand(ctx, data, apiObject)...)
+
+	return diags
+}
+
+// flattenUnionMember flattens a union member's Value into a single element block.
+func flattenUnionMember[T any](ctx context.Context, apiObject any, v *fwtypes.ListNestedObjectValueOf[T]) (diags diag.Diagnostics) {
+	var data T
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*v = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	return diags
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_ingress_point", name="Ingress Point")
+// @Tags(identifierAttribute="arn")
+func newIngressPointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ingressPointResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type ingressPointResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*ingressPointResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_ingress_point"
+}
+
+func (r *ingressPointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"a_record": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"ingress_point_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9_\-]+$`), ""),
+				},
+			},
+			"rule_set_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngressPointStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"traffic_policy_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngressPointType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ingress_point_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressPointConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("secret_arn"),
+									path.MatchRelative().AtParent().AtName("smtp_password"),
+								),
+							},
+						},
+						"smtp_password": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(8, 64),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ingressPointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	name := data.IngressPointName.ValueString()
+	input := mailmanager.CreateIngressPointInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIngressPoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SES Mail Manager Ingress Point (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.IngressPointId)
+
+	ingressPoint, err := waitIngressPointActive(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SES Mail Manager Ingress Point (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARecord = fwflex.StringToFramework(ctx, ingressPoint.ARecord)
+	data.IngressPointARN = fwflex.StringToFramework(ctx, ingressPoint.IngressPointArn)
+	data.Status = fwtypes.StringEnumValue(ingressPoint.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ingressPointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findIngressPointByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Ingress Point (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The SMTP password is write-only and is not returned by the API.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingressPointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	if !new.IngressPointConfiguration.Equal(old.IngressPointConfiguration) ||
+		!new.IngressPointName.Equal(old.IngressPointName) ||
+		!new.RuleSetID.Equal(old.RuleSetID) ||
+		!new.TrafficPolicyID.Equal(old.TrafficPolicyID) {
+		input := mailmanager.UpdateIngressPointInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.IngressPointId = fwflex.StringFromFramework(ctx, new.ID)
+		// Only send the authentication configuration when it changes, to avoid needlessly rotating the password.
+		if new.IngressPointConfiguration.Equal(old.IngressPointConfiguration) {
+			input.IngressPointConfiguration = nil
+		}
+
+		_, err := conn.UpdateIngressPoint(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SES Mail Manager Ingress Point (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		ingressPoint, err := waitIngressPointActive(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for SES Mail Manager Ingress Point (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ARecord = fwflex.StringToFramework(ctx, ingressPoint.ARecord)
+		new.Status = fwtypes.StringEnumValue(ingressPoint.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ingressPointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	_, err := conn.DeleteIngressPoint(ctx, &mailmanager.DeleteIngressPointInput{
+		IngressPointId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SES Mail Manager Ingress Point (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIngressPointDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SES Mail Manager Ingress Point (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ingressPointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIngressPointByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetIngressPointOutput, error) {
+	input := &mailmanager.GetIngressPointInput{
+		IngressPointId: aws.String(id),
+	}
+
+	output, err := conn.GetIngressPoint(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIngressPoint(ctx context.Context, conn *mailmanager.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIngressPointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIngressPointActive(ctx context.Context, conn *mailmanager.Client, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngressPointStatusProvisioning, awstypes.IngressPointStatusUpdating),
+		Target:  enum.Slice(awstypes.IngressPointStatusActive),
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngressPointDeleted(ctx context.Context, conn *mailmanager.Client, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngressPointStatusActive, awstypes.IngressPointStatusClosed, awstypes.IngressPointStatusDeprovisioning),
+		Target:  []string{},
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type ingressPointResourceModel struct {
+	ARecord                   types.String                                                    `tfsdk:"a_record"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	IngressPointARN           types.String                                                    `tfsdk:"arn"`
+	IngressPointConfiguration fwtypes.ListNestedObjectValueOf[ingressPointConfigurationModel] `tfsdk:"ingress_point_configuration"`
+	IngressPointName          types.String                                                    `tfsdk:"ingress_point_name"`
+	RuleSetID                 types.String                                                    `tfsdk:"rule_set_id"`
+	Status                    fwtypes.StringEnum[awstypes.IngressPointStatus]                 `tfsdk:"status"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	TrafficPolicyID           types.String                                                    `tfsdk:"traffic_policy_id"`
+	Type                      fwtypes.StringEnum[awstypes.IngressPointType]                   `tfsdk:"type"`
+}
+
+type ingressPointConfigurationModel struct {
+	SecretARN    fwtypes.ARN  `tfsdk:"secret_arn"`
+	SMTPPassword types.String `tfsdk:"smtp_password"`
+}
+
+var (
+	_ fwflex.Expander = ingressPointConfigurationModel{}
+)
+
+func (m ingressPointConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.SecretARN.IsNull():
+		result = &awstypes.IngressPointConfigurationMemberSecretArn{
+			Value: m.SecretARN.ValueString(),
+		}
+	case !m.SMTPPassword.IsNull():
+		result = &awstypes.IngressPointConfigurationMemberSmtpPassword{
+			Value: m.SMTPPassword.ValueString(),
+		}
+	}
+
+	return result, diags
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerIngressPoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_ingress_point.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "a_record"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_set_id", "aws_mailmanager_rule_set.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_mailmanager_traffic_policy.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "OPEN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_ingress_point.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceIngressPoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_auth(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_ingress_point.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_auth(rName, "Password1234"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "AUTH"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ingress_point_configuration"},
+			},
+			{
+				Config: testAccIngressPointConfig_auth(rName, "Password5678"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_configuration.0.smtp_password", "Password5678"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIngressPointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_ingress_point" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindIngressPointByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SES Mail Manager Ingress Point %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngressPointExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		_, err := tfmailmanager.FindIngressPointByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIngressPointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    action {
+      drop {}
+    }
+  }
+}
+
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name = %[1]q
+  default_action      = "DENY"
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      ip_expression {
+        operator = "CIDR_MATCHES"
+        values   = ["10.0.0.0/8"]
+
+        evaluate {
+          attribute = "SENDER_IP"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccIngressPointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "OPEN"
+}
+`, rName))
+}
+
+func testAccIngressPointConfig_auth(rName, password string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "AUTH"
+
+  ingress_point_configuration {
+    smtp_password = %[2]q
+  }
+}
+`, rName, password))
+}
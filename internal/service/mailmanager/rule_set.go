@@ -0,0 +1,966 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_rule_set", name="Rule Set")
+// @Tags(identifierAttribute="arn")
+func newRuleSetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &ruleSetResource{}, nil
+}
+
+type ruleSetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ruleSetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_rule_set"
+}
+
+func (r *ruleSetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	actionFailurePolicyAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.ActionFailurePolicy](),
+		Optional:   true,
+	}
+	roleARNAttribute := schema.StringAttribute{
+		CustomType: fwtypes.ARNType,
+		Required:   true,
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"rule_set_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_.-]+$`), ""),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ruleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(40),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 32),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_.-]+$`), ""),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ruleActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"add_header": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[addHeaderActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"header_name": schema.StringAttribute{
+													Required: true,
+												},
+												"header_value": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"archive": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[archiveActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"action_failure_policy": actionFailurePolicyAttribute,
+												"target_archive": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"deliver_to_mailbox": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[deliverToMailboxActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"action_failure_policy": actionFailurePolicyAttribute,
+												"mailbox_arn": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrRoleARN: roleARNAttribute,
+											},
+										},
+									},
+									"drop": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dropActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{},
+									},
+									"relay": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[relayActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"action_failure_policy": actionFailurePolicyAttribute,
+												"mail_from": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.MailFrom](),
+													Optional:   true,
+												},
+												"relay": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"replace_recipient": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[replaceRecipientActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"replace_with": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+											},
+										},
+									},
+									"send": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[sendActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"action_failure_policy": actionFailurePolicyAttribute,
+												names.AttrRoleARN:       roleARNAttribute,
+											},
+										},
+									},
+									"write_to_s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3ActionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"action_failure_policy": actionFailurePolicyAttribute,
+												names.AttrRoleARN:       roleARNAttribute,
+												names.AttrS3Bucket: schema.StringAttribute{
+													Required: true,
+												},
+												"s3_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+												"s3_sse_kms_key_id": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrCondition: ruleConditionBlock(ctx),
+						"unless":            ruleConditionBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ruleSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ruleSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	name := data.RuleSetName.ValueString()
+	input := mailmanager.CreateRuleSetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateRuleSet(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SES Mail Manager Rule Set (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.RuleSetId)
+
+	ruleSet, err := findRuleSetByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Rule Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RuleSetARN = fwflex.StringToFramework(ctx, ruleSet.RuleSetArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ruleSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ruleSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findRuleSetByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Rule Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ruleSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ruleSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	if !new.RuleSetName.Equal(old.RuleSetName) || !new.Rules.Equal(old.Rules) {
+		input := mailmanager.UpdateRuleSetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.RuleSetId = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateRuleSet(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SES Mail Manager Rule Set (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ruleSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ruleSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	_, err := conn.DeleteRuleSet(ctx, &mailmanager.DeleteRuleSetInput{
+		RuleSetId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SES Mail Manager Rule Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ruleSetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findRuleSetByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetRuleSetOutput, error) {
+	input := &mailmanager.GetRuleSetInput{
+		RuleSetId: aws.String(id),
+	}
+
+	output, err := conn.GetRuleSet(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ruleConditionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ruleConditionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"boolean_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleBooleanExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleBooleanOperator](),
+								Required:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"evaluate": evaluateAttributeBlock[ruleBooleanToEvaluateModel, awstypes.RuleBooleanEmailAttribute](ctx),
+						},
+					},
+				},
+				"dmarc_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleDMARCExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleDmarcOperator](),
+								Required:   true,
+							},
+							names.AttrValues: schema.SetAttribute{
+								CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.RuleDmarcPolicy]](ctx),
+								ElementType: fwtypes.StringEnumType[awstypes.RuleDmarcPolicy](),
+								Required:    true,
+							},
+						},
+					},
+				},
+				"ip_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleIPExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleIpOperator](),
+								Required:   true,
+							},
+							names.AttrValues: schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								ElementType: types.StringType,
+								Required:    true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"evaluate": evaluateAttributeBlock[ruleIPToEvaluateModel, awstypes.RuleIpEmailAttribute](ctx),
+						},
+					},
+				},
+				"number_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleNumberExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleNumberOperator](),
+								Required:   true,
+							},
+							names.AttrValue: schema.Float64Attribute{
+								Required: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"evaluate": evaluateAttributeBlock[ruleNumberToEvaluateModel, awstypes.RuleNumberEmailAttribute](ctx),
+						},
+					},
+				},
+				"string_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleStringExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleStringOperator](),
+								Required:   true,
+							},
+							names.AttrValues: schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								ElementType: types.StringType,
+								Required:    true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"evaluate": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[ruleStringToEvaluateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"attribute": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.RuleStringEmailAttribute](),
+											Optional:   true,
+										},
+										"mime_header_attribute": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.RegexMatches(regexache.MustCompile(`^X-[a-zA-Z0-9-]{1,256}$`), ""),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"verdict_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ruleVerdictExpressionModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"operator": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.RuleVerdictOperator](),
+								Required:   true,
+							},
+							names.AttrValues: schema.SetAttribute{
+								CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.RuleVerdict]](ctx),
+								ElementType: fwtypes.StringEnumType[awstypes.RuleVerdict](),
+								Required:    true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"evaluate": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[ruleVerdictToEvaluateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"attribute": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.RuleVerdictAttribute](),
+											Optional:   true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"analysis": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[ingressAnalysisModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"analyzer": schema.StringAttribute{
+														Required: true,
+													},
+													"result_field": schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type ruleSetResourceModel struct {
+	ID          types.String                               `tfsdk:"id"`
+	RuleSetARN  types.String                               `tfsdk:"arn"`
+	RuleSetName types.String                               `tfsdk:"rule_set_name"`
+	Rules       fwtypes.ListNestedObjectValueOf[ruleModel] `tfsdk:"rule"`
+	Tags        tftags.Map                                 `tfsdk:"tags"`
+	TagsAll     tftags.Map                                 `tfsdk:"tags_all"`
+}
+
+type ruleModel struct {
+	Actions    fwtypes.ListNestedObjectValueOf[ruleActionModel]    `tfsdk:"action"`
+	Conditions fwtypes.ListNestedObjectValueOf[ruleConditionModel] `tfsdk:"condition"`
+	Name       types.String                                        `tfsdk:"name"`
+	Unless     fwtypes.ListNestedObjectValueOf[ruleConditionModel] `tfsdk:"unless"`
+}
+
+type ruleActionModel struct {
+	AddHeader        fwtypes.ListNestedObjectValueOf[addHeaderActionModel]        `tfsdk:"add_header"`
+	Archive          fwtypes.ListNestedObjectValueOf[archiveActionModel]          `tfsdk:"archive"`
+	DeliverToMailbox fwtypes.ListNestedObjectValueOf[deliverToMailboxActionModel] `tfsdk:"deliver_to_mailbox"`
+	Drop             fwtypes.ListNestedObjectValueOf[dropActionModel]             `tfsdk:"drop"`
+	Relay            fwtypes.ListNestedObjectValueOf[relayActionModel]            `tfsdk:"relay"`
+	ReplaceRecipient fwtypes.ListNestedObjectValueOf[replaceRecipientActionModel] `tfsdk:"replace_recipient"`
+	Send             fwtypes.ListNestedObjectValueOf[sendActionModel]             `tfsdk:"send"`
+	WriteToS3        fwtypes.ListNestedObjectValueOf[s3ActionModel]               `tfsdk:"write_to_s3"`
+}
+
+var (
+	_ fwflex.Expander  = ruleActionModel{}
+	_ fwflex.Flattener = &ruleActionModel{}
+)
+
+func (m ruleActionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.AddHeader.IsNull():
+		var r awstypes.RuleActionMemberAddHeader
+		diags.Append(expandUnionMember(ctx, m.AddHeader, &r.Value)...)
+		result = &r
+	case !m.Archive.IsNull():
+		var r awstypes.RuleActionMemberArchive
+		diags.Append(expandUnionMember(ctx, m.Archive, &r.Value)...)
+		result = &r
+	case !m.DeliverToMailbox.IsNull():
+		var r awstypes.RuleActionMemberDeliverToMailbox
+		diags.Append(expandUnionMember(ctx, m.DeliverToMailbox, &r.Value)...)
+		result = &r
+	case !m.Drop.IsNull():
+		result = &awstypes.RuleActionMemberDrop{}
+	case !m.Relay.IsNull():
+		var r awstypes.RuleActionMemberRelay
+		diags.Append(expandUnionMember(ctx, m.Relay, &r.Value)...)
+		result = &r
+	case !m.ReplaceRecipient.IsNull():
+		var r awstypes.RuleActionMemberReplaceRecipient
+		diags.Append(expandUnionMember(ctx, m.ReplaceRecipient, &r.Value)...)
+		result = &r
+	case !m.Send.IsNull():
+		var r awstypes.RuleActionMemberSend
+		diags.Append(expandUnionMember(ctx, m.Send, &r.Value)...)
+		result = &r
+	case !m.WriteToS3.IsNull():
+		var r awstypes.RuleActionMemberWriteToS3
+		diags.Append(expandUnionMember(ctx, m.WriteToS3, &r.Value)...)
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *ruleActionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleActionMemberAddHeader:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.AddHeader)...)
+	case awstypes.RuleActionMemberArchive:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Archive)...)
+	case awstypes.RuleActionMemberDeliverToMailbox:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.DeliverToMailbox)...)
+	case awstypes.RuleActionMemberDrop:
+		m.Drop = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dropActionModel{})
+	case awstypes.RuleActionMemberRelay:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Relay)...)
+	case awstypes.RuleActionMemberReplaceRecipient:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.ReplaceRecipient)...)
+	case awstypes.RuleActionMemberSend:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Send)...)
+	case awstypes.RuleActionMemberWriteToS3:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.WriteToS3)...)
+	}
+
+	return diags
+}
+
+type addHeaderActionModel struct {
+	HeaderName  types.String `tfsdk:"header_name"`
+	HeaderValue types.String `tfsdk:"header_value"`
+}
+
+type archiveActionModel struct {
+	ActionFailurePolicy fwtypes.StringEnum[awstypes.ActionFailurePolicy] `tfsdk:"action_failure_policy"`
+	TargetArchive       types.String                                     `tfsdk:"target_archive"`
+}
+
+type deliverToMailboxActionModel struct {
+	ActionFailurePolicy fwtypes.StringEnum[awstypes.ActionFailurePolicy] `tfsdk:"action_failure_policy"`
+	MailboxARN          types.String                                     `tfsdk:"mailbox_arn"`
+	RoleARN             fwtypes.ARN                                      `tfsdk:"role_arn"`
+}
+
+type dropActionModel struct{}
+
+type relayActionModel struct {
+	ActionFailurePolicy fwtypes.StringEnum[awstypes.ActionFailurePolicy] `tfsdk:"action_failure_policy"`
+	MailFrom            fwtypes.StringEnum[awstypes.MailFrom]            `tfsdk:"mail_from"`
+	Relay               types.String                                     `tfsdk:"relay"`
+}
+
+type replaceRecipientActionModel struct {
+	ReplaceWith fwtypes.ListValueOf[types.String] `tfsdk:"replace_with"`
+}
+
+type sendActionModel struct {
+	ActionFailurePolicy fwtypes.StringEnum[awstypes.ActionFailurePolicy] `tfsdk:"action_failure_policy"`
+	RoleARN             fwtypes.ARN                                      `tfsdk:"role_arn"`
+}
+
+type s3ActionModel struct {
+	ActionFailurePolicy fwtypes.StringEnum[awstypes.ActionFailurePolicy] `tfsdk:"action_failure_policy"`
+	RoleARN             fwtypes.ARN                                      `tfsdk:"role_arn"`
+	S3Bucket            types.String                                     `tfsdk:"s3_bucket"`
+	S3Prefix            types.String                                     `tfsdk:"s3_prefix"`
+	S3SseKmsKeyId       types.String                                     `tfsdk:"s3_sse_kms_key_id"`
+}
+
+type ruleConditionModel struct {
+	BooleanExpression fwtypes.ListNestedObjectValueOf[ruleBooleanExpressionModel] `tfsdk:"boolean_expression"`
+	DmarcExpression   fwtypes.ListNestedObjectValueOf[ruleDMARCExpressionModel]   `tfsdk:"dmarc_expression"`
+	IpExpression      fwtypes.ListNestedObjectValueOf[ruleIPExpressionModel]      `tfsdk:"ip_expression"`
+	NumberExpression  fwtypes.ListNestedObjectValueOf[ruleNumberExpressionModel]  `tfsdk:"number_expression"`
+	StringExpression  fwtypes.ListNestedObjectValueOf[ruleStringExpressionModel]  `tfsdk:"string_expression"`
+	VerdictExpression fwtypes.ListNestedObjectValueOf[ruleVerdictExpressionModel] `tfsdk:"verdict_expression"`
+}
+
+var (
+	_ fwflex.Expander  = ruleConditionModel{}
+	_ fwflex.Flattener = &ruleConditionModel{}
+)
+
+func (m ruleConditionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BooleanExpression.IsNull():
+		var r awstypes.RuleConditionMemberBooleanExpression
+		diags.Append(expandUnionMember(ctx, m.BooleanExpression, &r.Value)...)
+		result = &r
+	case !m.DmarcExpression.IsNull():
+		var r awstypes.RuleConditionMemberDmarcExpression
+		diags.Append(expandUnionMember(ctx, m.DmarcExpression, &r.Value)...)
+		result = &r
+	case !m.IpExpression.IsNull():
+		var r awstypes.RuleConditionMemberIpExpression
+		diags.Append(expandUnionMember(ctx, m.IpExpression, &r.Value)...)
+		result = &r
+	case !m.NumberExpression.IsNull():
+		var r awstypes.RuleConditionMemberNumberExpression
+		diags.Append(expandUnionMember(ctx, m.NumberExpression, &r.Value)...)
+		result = &r
+	case !m.StringExpression.IsNull():
+		var r awstypes.RuleConditionMemberStringExpression
+		diags.Append(expandUnionMember(ctx, m.StringExpression, &r.Value)...)
+		result = &r
+	case !m.VerdictExpression.IsNull():
+		var r awstypes.RuleConditionMemberVerdictExpression
+		diags.Append(expandUnionMember(ctx, m.VerdictExpression, &r.Value)...)
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *ruleConditionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleConditionMemberBooleanExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.BooleanExpression)...)
+	case awstypes.RuleConditionMemberDmarcExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.DmarcExpression)...)
+	case awstypes.RuleConditionMemberIpExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.IpExpression)...)
+	case awstypes.RuleConditionMemberNumberExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.NumberExpression)...)
+	case awstypes.RuleConditionMemberStringExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.StringExpression)...)
+	case awstypes.RuleConditionMemberVerdictExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.VerdictExpression)...)
+	}
+
+	return diags
+}
+
+type ruleBooleanExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ruleBooleanToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.RuleBooleanOperator]            `tfsdk:"operator"`
+}
+
+type ruleBooleanToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.RuleBooleanEmailAttribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ruleBooleanToEvaluateModel{}
+	_ fwflex.Flattener = &ruleBooleanToEvaluateModel{}
+)
+
+func (m ruleBooleanToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.RuleBooleanToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ruleBooleanToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleBooleanToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ruleDMARCExpressionModel struct {
+	Operator fwtypes.StringEnum[awstypes.RuleDmarcOperator]                   `tfsdk:"operator"`
+	Values   fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.RuleDmarcPolicy]] `tfsdk:"values"`
+}
+
+type ruleIPExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ruleIPToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.RuleIpOperator]            `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]                      `tfsdk:"values"`
+}
+
+type ruleIPToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.RuleIpEmailAttribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ruleIPToEvaluateModel{}
+	_ fwflex.Flattener = &ruleIPToEvaluateModel{}
+)
+
+func (m ruleIPToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.RuleIpToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ruleIPToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleIpToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ruleNumberExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ruleNumberToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.RuleNumberOperator]            `tfsdk:"operator"`
+	Value    types.Float64                                              `tfsdk:"value"`
+}
+
+type ruleNumberToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.RuleNumberEmailAttribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ruleNumberToEvaluateModel{}
+	_ fwflex.Flattener = &ruleNumberToEvaluateModel{}
+)
+
+func (m ruleNumberToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.RuleNumberToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ruleNumberToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleNumberToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ruleStringExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ruleStringToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.RuleStringOperator]            `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]                          `tfsdk:"values"`
+}
+
+type ruleStringToEvaluateModel struct {
+	Attribute           fwtypes.StringEnum[awstypes.RuleStringEmailAttribute] `tfsdk:"attribute"`
+	MimeHeaderAttribute types.String                                          `tfsdk:"mime_header_attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ruleStringToEvaluateModel{}
+	_ fwflex.Flattener = &ruleStringToEvaluateModel{}
+)
+
+func (m ruleStringToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.RuleStringToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	case !m.MimeHeaderAttribute.IsNull():
+		result = &awstypes.RuleStringToEvaluateMemberMimeHeaderAttribute{
+			Value: m.MimeHeaderAttribute.ValueString(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ruleStringToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleStringToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	case awstypes.RuleStringToEvaluateMemberMimeHeaderAttribute:
+		m.MimeHeaderAttribute = types.StringValue(t.Value)
+	}
+
+	return diags
+}
+
+type ruleVerdictExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ruleVerdictToEvaluateModel]  `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.RuleVerdictOperator]             `tfsdk:"operator"`
+	Values   fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.RuleVerdict]] `tfsdk:"values"`
+}
+
+type ruleVerdictToEvaluateModel struct {
+	Analysis  fwtypes.ListNestedObjectValueOf[ingressAnalysisModel] `tfsdk:"analysis"`
+	Attribute fwtypes.StringEnum[awstypes.RuleVerdictAttribute]     `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ruleVerdictToEvaluateModel{}
+	_ fwflex.Flattener = &ruleVerdictToEvaluateModel{}
+)
+
+func (m ruleVerdictToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Analysis.IsNull():
+		var r awstypes.RuleVerdictToEvaluateMemberAnalysis
+		diags.Append(expandUnionMember(ctx, m.Analysis, &r.Value)...)
+		result = &r
+	case !m.Attribute.IsNull():
+		result = &awstypes.RuleVerdictToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ruleVerdictToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RuleVerdictToEvaluateMemberAnalysis:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Analysis)...)
+	case awstypes.RuleVerdictToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerRuleSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_rule_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.drop.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleSetConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "archive-all"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.action.0.archive.0.target_archive", "aws_mailmanager_archive.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.0.string_expression.0.evaluate.0.attribute", "SUBJECT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerRuleSet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mailmanager_rule_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceRuleSet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuleSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_rule_set" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindRuleSetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SES Mail Manager Rule Set %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuleSetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		_, err := tfmailmanager.FindRuleSetByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRuleSetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    action {
+      drop {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleSetConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+
+  retention {
+    retention_period = "THREE_MONTHS"
+  }
+}
+
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "archive-all"
+
+    action {
+      archive {
+        target_archive = aws_mailmanager_archive.test.id
+      }
+    }
+
+    action {
+      add_header {
+        header_name  = "X-Archived"
+        header_value = "true"
+      }
+    }
+
+    condition {
+      string_expression {
+        operator = "CONTAINS"
+        values   = ["invoice"]
+
+        evaluate {
+          attribute = "SUBJECT"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,665 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_traffic_policy", name="Traffic Policy")
+// @Tags(identifierAttribute="arn")
+func newTrafficPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &trafficPolicyResource{}, nil
+}
+
+type trafficPolicyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*trafficPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_traffic_policy"
+}
+
+func (r *trafficPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_action": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AcceptAction](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"max_message_size_bytes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"traffic_policy_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9_\-]+$`), ""),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policy_statement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyStatementModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AcceptAction](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrCondition: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyConditionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"boolean_expression": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[ingressBooleanExpressionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("boolean_expression"),
+												path.MatchRelative().AtParent().AtName("ip_expression"),
+												path.MatchRelative().AtParent().AtName("ipv6_expression"),
+												path.MatchRelative().AtParent().AtName("string_expression"),
+												path.MatchRelative().AtParent().AtName("tls_expression"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"operator": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.IngressBooleanOperator](),
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"evaluate": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[ingressBooleanToEvaluateModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"analysis": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[ingressAnalysisModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"analyzer": schema.StringAttribute{
+																			Required: true,
+																		},
+																		"result_field": schema.StringAttribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"ip_expression":   ingressIPExpressionBlock[ingressIPv4ExpressionModel, ingressIPToEvaluateModel, awstypes.IngressIpv4Attribute](ctx),
+									"ipv6_expression": ingressIPExpressionBlock[ingressIPv6ExpressionModel, ingressIPv6ToEvaluateModel, awstypes.IngressIpv6Attribute](ctx),
+									"string_expression": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[ingressStringExpressionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"operator": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.IngressStringOperator](),
+													Required:   true,
+												},
+												names.AttrValues: schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"evaluate": evaluateAttributeBlock[ingressStringToEvaluateModel, awstypes.IngressStringEmailAttribute](ctx),
+											},
+										},
+									},
+									"tls_expression": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[ingressTLSProtocolExpressionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"operator": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.IngressTlsProtocolOperator](),
+													Required:   true,
+												},
+												names.AttrValue: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.IngressTlsProtocolAttribute](),
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"evaluate": evaluateAttributeBlock[ingressTLSProtocolToEvaluateModel, awstypes.IngressTlsAttribute](ctx),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *trafficPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trafficPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	name := data.TrafficPolicyName.ValueString()
+	input := mailmanager.CreateTrafficPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTrafficPolicy(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SES Mail Manager Traffic Policy (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.TrafficPolicyId)
+
+	policy, err := findTrafficPolicyByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Traffic Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TrafficPolicyARN = fwflex.StringToFramework(ctx, policy.TrafficPolicyArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trafficPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trafficPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findTrafficPolicyByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SES Mail Manager Traffic Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trafficPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trafficPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	if !new.DefaultAction.Equal(old.DefaultAction) ||
+		!new.MaxMessageSizeBytes.Equal(old.MaxMessageSizeBytes) ||
+		!new.PolicyStatements.Equal(old.PolicyStatements) ||
+		!new.TrafficPolicyName.Equal(old.TrafficPolicyName) {
+		input := mailmanager.UpdateTrafficPolicyInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.TrafficPolicyId = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateTrafficPolicy(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SES Mail Manager Traffic Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trafficPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trafficPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	_, err := conn.DeleteTrafficPolicy(ctx, &mailmanager.DeleteTrafficPolicyInput{
+		TrafficPolicyId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SES Mail Manager Traffic Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trafficPolicyResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTrafficPolicyByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetTrafficPolicyOutput, error) {
+	input := &mailmanager.GetTrafficPolicyInput{
+		TrafficPolicyId: aws.String(id),
+	}
+
+	output, err := conn.GetTrafficPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// evaluateAttributeBlock returns the schema for an "evaluate" block whose only option is an email attribute.
+func evaluateAttributeBlock[T any, E enum.Valueser[E]](ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[E](),
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func ingressIPExpressionBlock[T, U any, E enum.Valueser[E]](ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"operator": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.IngressIpOperator](),
+					Required:   true,
+				},
+				names.AttrValues: schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"evaluate": evaluateAttributeBlock[U, E](ctx),
+			},
+		},
+	}
+}
+
+type trafficPolicyResourceModel struct {
+	DefaultAction       fwtypes.StringEnum[awstypes.AcceptAction]             `tfsdk:"default_action"`
+	ID                  types.String                                          `tfsdk:"id"`
+	MaxMessageSizeBytes types.Int64                                           `tfsdk:"max_message_size_bytes"`
+	PolicyStatements    fwtypes.ListNestedObjectValueOf[policyStatementModel] `tfsdk:"policy_statement"`
+	Tags                tftags.Map                                            `tfsdk:"tags"`
+	TagsAll             tftags.Map                                            `tfsdk:"tags_all"`
+	TrafficPolicyARN    types.String                                          `tfsdk:"arn"`
+	TrafficPolicyName   types.String                                          `tfsdk:"traffic_policy_name"`
+}
+
+type policyStatementModel struct {
+	Action     fwtypes.StringEnum[awstypes.AcceptAction]             `tfsdk:"action"`
+	Conditions fwtypes.ListNestedObjectValueOf[policyConditionModel] `tfsdk:"condition"`
+}
+
+type policyConditionModel struct {
+	BooleanExpression fwtypes.ListNestedObjectValueOf[ingressBooleanExpressionModel]     `tfsdk:"boolean_expression"`
+	IpExpression      fwtypes.ListNestedObjectValueOf[ingressIPv4ExpressionModel]        `tfsdk:"ip_expression"`
+	Ipv6Expression    fwtypes.ListNestedObjectValueOf[ingressIPv6ExpressionModel]        `tfsdk:"ipv6_expression"`
+	StringExpression  fwtypes.ListNestedObjectValueOf[ingressStringExpressionModel]      `tfsdk:"string_expression"`
+	TlsExpression     fwtypes.ListNestedObjectValueOf[ingressTLSProtocolExpressionModel] `tfsdk:"tls_expression"`
+}
+
+var (
+	_ fwflex.Expander  = policyConditionModel{}
+	_ fwflex.Flattener = &policyConditionModel{}
+)
+
+func (m policyConditionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BooleanExpression.IsNull():
+		var r awstypes.PolicyConditionMemberBooleanExpression
+		diags.Append(expandUnionMember(ctx, m.BooleanExpression, &r.Value)...)
+		result = &r
+	case !m.IpExpression.IsNull():
+		var r awstypes.PolicyConditionMemberIpExpression
+		diags.Append(expandUnionMember(ctx, m.IpExpression, &r.Value)...)
+		result = &r
+	case !m.Ipv6Expression.IsNull():
+		var r awstypes.PolicyConditionMemberIpv6Expression
+		diags.Append(expandUnionMember(ctx, m.Ipv6Expression, &r.Value)...)
+		result = &r
+	case !m.StringExpression.IsNull():
+		var r awstypes.PolicyConditionMemberStringExpression
+		diags.Append(expandUnionMember(ctx, m.StringExpression, &r.Value)...)
+		result = &r
+	case !m.TlsExpression.IsNull():
+		var r awstypes.PolicyConditionMemberTlsExpression
+		diags.Append(expandUnionMember(ctx, m.TlsExpression, &r.Value)...)
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *policyConditionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PolicyConditionMemberBooleanExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.BooleanExpression)...)
+	case awstypes.PolicyConditionMemberIpExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.IpExpression)...)
+	case awstypes.PolicyConditionMemberIpv6Expression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Ipv6Expression)...)
+	case awstypes.PolicyConditionMemberStringExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.StringExpression)...)
+	case awstypes.PolicyConditionMemberTlsExpression:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.TlsExpression)...)
+	}
+
+	return diags
+}
+
+type ingressBooleanExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ingressBooleanToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.IngressBooleanOperator]            `tfsdk:"operator"`
+}
+
+type ingressBooleanToEvaluateModel struct {
+	Analysis fwtypes.ListNestedObjectValueOf[ingressAnalysisModel] `tfsdk:"analysis"`
+}
+
+var (
+	_ fwflex.Expander  = ingressBooleanToEvaluateModel{}
+	_ fwflex.Flattener = &ingressBooleanToEvaluateModel{}
+)
+
+func (m ingressBooleanToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Analysis.IsNull():
+		var r awstypes.IngressBooleanToEvaluateMemberAnalysis
+		diags.Append(expandUnionMember(ctx, m.Analysis, &r.Value)...)
+		result = &r
+	}
+
+	return result, diags
+}
+
+func (m *ingressBooleanToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IngressBooleanToEvaluateMemberAnalysis:
+		diags.Append(flattenUnionMember(ctx, t.Value, &m.Analysis)...)
+	}
+
+	return diags
+}
+
+type ingressAnalysisModel struct {
+	Analyzer    types.String `tfsdk:"analyzer"`
+	ResultField types.String `tfsdk:"result_field"`
+}
+
+type ingressIPv4ExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ingressIPToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.IngressIpOperator]            `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]                         `tfsdk:"values"`
+}
+
+type ingressIPToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.IngressIpv4Attribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ingressIPToEvaluateModel{}
+	_ fwflex.Flattener = &ingressIPToEvaluateModel{}
+)
+
+func (m ingressIPToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.IngressIpToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ingressIPToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IngressIpToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ingressIPv6ExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ingressIPv6ToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.IngressIpOperator]              `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]                           `tfsdk:"values"`
+}
+
+type ingressIPv6ToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.IngressIpv6Attribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ingressIPv6ToEvaluateModel{}
+	_ fwflex.Flattener = &ingressIPv6ToEvaluateModel{}
+)
+
+func (m ingressIPv6ToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.IngressIpv6ToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ingressIPv6ToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IngressIpv6ToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ingressStringExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ingressStringToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.IngressStringOperator]            `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]                             `tfsdk:"values"`
+}
+
+type ingressStringToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.IngressStringEmailAttribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ingressStringToEvaluateModel{}
+	_ fwflex.Flattener = &ingressStringToEvaluateModel{}
+)
+
+func (m ingressStringToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.IngressStringToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ingressStringToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IngressStringToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type ingressTLSProtocolExpressionModel struct {
+	Evaluate fwtypes.ListNestedObjectValueOf[ingressTLSProtocolToEvaluateModel] `tfsdk:"evaluate"`
+	Operator fwtypes.StringEnum[awstypes.IngressTlsProtocolOperator]            `tfsdk:"operator"`
+	Value    fwtypes.StringEnum[awstypes.IngressTlsProtocolAttribute]           `tfsdk:"value"`
+}
+
+type ingressTLSProtocolToEvaluateModel struct {
+	Attribute fwtypes.StringEnum[awstypes.IngressTlsAttribute] `tfsdk:"attribute"`
+}
+
+var (
+	_ fwflex.Expander  = ingressTLSProtocolToEvaluateModel{}
+	_ fwflex.Flattener = &ingressTLSProtocolToEvaluateModel{}
+)
+
+func (m ingressTLSProtocolToEvaluateModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Attribute.IsNull():
+		result = &awstypes.IngressTlsProtocolToEvaluateMemberAttribute{
+			Value: m.Attribute.ValueEnum(),
+		}
+	}
+
+	return result, diags
+}
+
+func (m *ingressTLSProtocolToEvaluateModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IngressTlsProtocolToEvaluateMemberAttribute:
+		m.Attribute = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
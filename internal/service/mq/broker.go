@@ -31,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -105,6 +106,22 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"data_replication_counterpart": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"data_replication_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -127,6 +144,18 @@ func resourceBroker() *schema.Resource {
 				ForceNew:     true, // Can only be set on Create
 				ValidateFunc: verify.ValidARN,
 			},
+			"data_replication_promote_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.PromoteModeSwitchover,
+				ValidateDiagFunc: enum.Validate[types.PromoteMode](),
+			},
+			"data_replication_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dataReplicationRole_Values(), false),
+			},
 			"deployment_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -484,7 +513,15 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("authentication_strategy", output.AuthenticationStrategy)
 	d.Set(names.AttrAutoMinorVersionUpgrade, output.AutoMinorVersionUpgrade)
 	d.Set("broker_name", output.BrokerName)
+	if err := d.Set("data_replication_counterpart", flattenDataReplicationCounterpart(output.DataReplicationMetadata)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_replication_counterpart: %s", err)
+	}
 	d.Set("data_replication_mode", output.DataReplicationMode)
+	if v := output.DataReplicationMetadata; v != nil {
+		d.Set("data_replication_role", v.DataReplicationRole)
+	} else {
+		d.Set("data_replication_role", nil)
+	}
 	d.Set("deployment_mode", output.DeploymentMode)
 	d.Set("engine_type", output.EngineType)
 	d.Set(names.AttrEngineVersion, normalizeEngineVersion(string(output.EngineType), aws.ToString(output.EngineVersion), aws.ToBool(output.AutoMinorVersionUpgrade)))
@@ -650,6 +687,12 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		requiresReboot = true
 	}
 
+	if d.HasChange("data_replication_role") {
+		if err := promoteBroker(ctx, conn, d.Id(), d.Get("data_replication_role").(string), types.PromoteMode(d.Get("data_replication_promote_mode").(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.Get(names.AttrApplyImmediately).(bool) && requiresReboot {
 		_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
 			BrokerId: aws.String(d.Id()),
@@ -667,6 +710,53 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+// promoteBroker switches the data replication role of the specified broker.
+// A replica broker is promoted directly. A primary broker is demoted by promoting its counterpart,
+// as the MQ API only supports promotion.
+func promoteBroker(ctx context.Context, conn *mq.Client, id, role string, mode types.PromoteMode, timeout time.Duration) error {
+	output, err := findBrokerByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading MQ Broker (%s): %w", id, err)
+	}
+
+	metadata := output.DataReplicationMetadata
+	if metadata == nil || metadata.DataReplicationCounterpart == nil {
+		return fmt.Errorf("MQ Broker (%s) is not part of an active data replication pair", id)
+	}
+
+	if aws.ToString(metadata.DataReplicationRole) == role {
+		return nil
+	}
+
+	promoteID := id
+	var optFns []func(*mq.Options)
+	if role == dataReplicationRoleReplica {
+		counterpart := metadata.DataReplicationCounterpart
+		promoteID = aws.ToString(counterpart.BrokerId)
+		optFns = append(optFns, func(o *mq.Options) {
+			o.Region = aws.ToString(counterpart.Region)
+		})
+	}
+
+	input := &mq.PromoteInput{
+		BrokerId: aws.String(promoteID),
+		Mode:     mode,
+	}
+
+	_, err = conn.Promote(ctx, input, optFns...)
+
+	if err != nil {
+		return fmt.Errorf("promoting MQ Broker (%s): %w", promoteID, err)
+	}
+
+	if _, err := waitBrokerDataReplicationRoleUpdated(ctx, conn, id, role, timeout); err != nil {
+		return fmt.Errorf("waiting for MQ Broker (%s) data replication role update: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -786,6 +876,43 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+func statusBrokerDataReplicationRole(ctx context.Context, conn *mq.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBrokerByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Don't report the new role until the broker is available again.
+		if output.BrokerState != types.BrokerStateRunning || output.DataReplicationMetadata == nil {
+			return output, "", nil
+		}
+
+		return output, aws.ToString(output.DataReplicationMetadata.DataReplicationRole), nil
+	}
+}
+
+func waitBrokerDataReplicationRoleUpdated(ctx context.Context, conn *mq.Client, id, role string, timeout time.Duration) (*mq.DescribeBrokerOutput, error) {
+	stateConf := retry.StateChangeConf{
+		Pending: append([]string{""}, tfslices.Filter(dataReplicationRole_Values(), func(v string) bool { return v != role })...),
+		Target:  []string{role},
+		Timeout: timeout,
+		Refresh: statusBrokerDataReplicationRole(ctx, conn, id),
+	}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mq.DescribeBrokerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func resourceUserHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1304,6 +1431,31 @@ func expandLDAPServerMetadata(tfList []interface{}) *types.LdapServerMetadataInp
 	return apiObject
 }
 
+const (
+	dataReplicationRolePrimary = "PRIMARY"
+	dataReplicationRoleReplica = "REPLICA"
+)
+
+func dataReplicationRole_Values() []string {
+	return []string{
+		dataReplicationRolePrimary,
+		dataReplicationRoleReplica,
+	}
+}
+
+func flattenDataReplicationCounterpart(apiObject *types.DataReplicationMetadataOutput) []interface{} {
+	if apiObject == nil || apiObject.DataReplicationCounterpart == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"broker_id":      aws.ToString(apiObject.DataReplicationCounterpart.BrokerId),
+		names.AttrRegion: aws.ToString(apiObject.DataReplicationCounterpart.Region),
+	}
+
+	return []interface{}{tfMap}
+}
+
 var ValidateBrokerName = validation.All(
 	validation.StringLenBetween(1, 50),
 	validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), ""),
@@ -1617,6 +1617,7 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data_replication_mode", ""),
 					resource.TestCheckResourceAttr(resourceName, "pending_data_replication_mode", string(types.DataReplicationModeCrdr)),
 					resource.TestCheckResourceAttrPair(resourceName, "data_replication_primary_broker_arn", primaryBrokerResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_replication_promote_mode", string(types.PromoteModeSwitchover)),
 				),
 			},
 			{
@@ -1624,7 +1625,7 @@ func TestAccMQBroker_dataReplicationMode(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user", "data_replication_primary_broker_arn"},
+				ImportStateVerifyIgnore: []string{names.AttrApplyImmediately, "user", "data_replication_primary_broker_arn", "data_replication_promote_mode"},
 			},
 			{
 				// Preparation for destruction would require multiple configuration changes
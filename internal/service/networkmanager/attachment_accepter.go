@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -48,10 +49,15 @@ func resourceAttachmentAccepter() *schema.Resource {
 			// querying attachments requires knowing the type ahead of time
 			// therefore type is required in provider, though not on the API
 			"attachment_type": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: enum.Validate[awstypes.AttachmentType](),
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(
+					awstypes.AttachmentTypeConnect,
+					awstypes.AttachmentTypeSiteToSiteVpn,
+					awstypes.AttachmentTypeTransitGatewayRouteTable,
+					awstypes.AttachmentTypeVpc,
+				), false),
 			},
 			"core_network_arn": {
 				Type:     schema.TypeString,
@@ -248,6 +254,9 @@ func resourceAttachmentAccepterRead(ctx context.Context, d *schema.ResourceData,
 		}
 
 		a = tgwAttachment.Attachment
+
+	default:
+		return sdkdiag.AppendErrorf(diags, "unsupported Network Manager Attachment type: %s", attachmentType)
 	}
 
 	d.Set("attachment_policy_rule_number", a.AttachmentPolicyRuleNumber)
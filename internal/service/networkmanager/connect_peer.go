@@ -66,7 +66,7 @@ func resourceConnectPeer() *schema.Resource {
 						"peer_asn": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 2147483647),
+							ValidateFunc: validation.IntBetween(1, 4294967295),
 						},
 					},
 				},
@@ -174,8 +174,10 @@ func resourceConnectPeer() *schema.Resource {
 				),
 			},
 			"subnet_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"inside_cidr_blocks"},
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 500),
 					validation.StringMatch(regexache.MustCompile(`^arn:[^:]{1,63}:ec2:[^:]{0,63}:[^:]{0,63}:subnet\/subnet-[0-9a-f]{8,17}$|^$`), "Must be a valid subnet ARN"),
@@ -284,9 +286,17 @@ func resourceConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta i
 		Resource:  fmt.Sprintf("connect-peer/%s", d.Id()),
 	}.String()
 	d.Set(names.AttrARN, arn)
-	bgpOptions := map[string]interface{}{}
-	bgpOptions["peer_asn"] = connectPeer.Configuration.BgpConfigurations[0].PeerAsn
-	d.Set("bgp_options", []interface{}{bgpOptions})
+	// Both BGP configurations of a Connect peer share the peer ASN.
+	if v := connectPeer.Configuration.BgpConfigurations; len(v) > 0 {
+		bgpOptions := map[string]interface{}{
+			"peer_asn": aws.ToInt64(v[0].PeerAsn),
+		}
+		if err := d.Set("bgp_options", []interface{}{bgpOptions}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting bgp_options: %s", err)
+		}
+	} else {
+		d.Set("bgp_options", nil)
+	}
 	d.Set(names.AttrConfiguration, []interface{}{flattenPeerConfiguration(connectPeer.Configuration)})
 	d.Set("connect_peer_id", connectPeer.ConnectPeerId)
 	d.Set("core_network_id", connectPeer.CoreNetworkId)
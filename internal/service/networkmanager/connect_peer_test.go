@@ -135,6 +135,11 @@ func TestAccNetworkManagerConnectPeer_subnetARN(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.peer_address", peerAddress),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.protocol", "NO_ENCAP"),
 					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.0.core_network_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.0.core_network_asn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.0.peer_address", peerAddress),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.bgp_configurations.0.peer_asn", asn),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration.0.bgp_configurations.1.core_network_address"),
 					resource.TestCheckResourceAttrSet(resourceName, "connect_attachment_id"),
 					resource.TestCheckResourceAttr(resourceName, "peer_address", peerAddress),
 					resource.TestCheckResourceAttr(resourceName, "edge_location", acctest.Region()),
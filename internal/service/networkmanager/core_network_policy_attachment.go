@@ -53,6 +53,7 @@ func resourceCoreNetworkPolicyAttachment() *schema.Resource {
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 10000000),
 					validation.StringIsJSON,
+					validCoreNetworkPolicyDocument,
 				),
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 				StateFunc: func(v interface{}) string {
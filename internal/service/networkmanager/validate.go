@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// validCoreNetworkPolicyDocument performs plan-time validation of the top-level structure of a Core Network policy document.
+// Full semantic validation is only performed by the service when the policy is put.
+func validCoreNetworkPolicyDocument(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	var document struct {
+		Version                  string `json:"version"`
+		CoreNetworkConfiguration *struct {
+			AsnRanges     []string          `json:"asn-ranges"`
+			EdgeLocations []json.RawMessage `json:"edge-locations"`
+		} `json:"core-network-configuration"`
+		Segments []struct {
+			Name string `json:"name"`
+		} `json:"segments"`
+	}
+
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid Core Network policy document: %w", k, err))
+		return
+	}
+
+	if document.Version == "" {
+		errors = append(errors, fmt.Errorf("%q: Core Network policy document must contain \"version\"", k))
+	}
+
+	if v := document.CoreNetworkConfiguration; v == nil {
+		errors = append(errors, fmt.Errorf("%q: Core Network policy document must contain \"core-network-configuration\"", k))
+	} else {
+		if len(v.AsnRanges) == 0 {
+			errors = append(errors, fmt.Errorf("%q: \"core-network-configuration\" must contain at least one \"asn-ranges\" entry", k))
+		}
+		if len(v.EdgeLocations) == 0 {
+			errors = append(errors, fmt.Errorf("%q: \"core-network-configuration\" must contain at least one \"edge-locations\" entry", k))
+		}
+	}
+
+	if len(document.Segments) == 0 {
+		errors = append(errors, fmt.Errorf("%q: Core Network policy document must contain at least one \"segments\" entry", k))
+	}
+
+	for i, segment := range document.Segments {
+		if segment.Name == "" {
+			errors = append(errors, fmt.Errorf("%q: \"segments\" entry %d must contain \"name\"", k, i))
+		}
+	}
+
+	return
+}
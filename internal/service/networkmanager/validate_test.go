@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"testing"
+)
+
+func TestValidCoreNetworkPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	validDocuments := []string{
+		`{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"],
+    "edge-locations": [{"location": "us-west-2"}]
+  },
+  "segments": [{"name": "segment"}]
+}`,
+		`{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "vpn-ecmp-support": false,
+    "asn-ranges": ["64512-64555"],
+    "edge-locations": [{"location": "us-west-2", "asn": 64512}, {"location": "us-east-1"}]
+  },
+  "segments": [{"name": "segment", "require-attachment-acceptance": true}, {"name": "segment2"}],
+  "segment-actions": [{"action": "share", "mode": "attachment-route", "segment": "segment", "share-with": "*"}]
+}`,
+	}
+	for _, v := range validDocuments {
+		_, errors := validCoreNetworkPolicyDocument(v, "policy_document")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Core Network policy document: %q", v, errors)
+		}
+	}
+
+	invalidDocuments := []string{
+		`{}`,
+		`[]`,
+		`{
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"],
+    "edge-locations": [{"location": "us-west-2"}]
+  },
+  "segments": [{"name": "segment"}]
+}`,
+		`{
+  "version": "2021.12",
+  "segments": [{"name": "segment"}]
+}`,
+		`{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"]
+  },
+  "segments": [{"name": "segment"}]
+}`,
+		`{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"],
+    "edge-locations": [{"location": "us-west-2"}]
+  }
+}`,
+		`{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": ["64512-64555"],
+    "edge-locations": [{"location": "us-west-2"}]
+  },
+  "segments": [{"description": "no name"}]
+}`,
+	}
+	for _, v := range invalidDocuments {
+		_, errors := validCoreNetworkPolicyDocument(v, "policy_document")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Core Network policy document", v)
+		}
+	}
+}
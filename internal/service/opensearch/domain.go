@@ -5,8 +5,10 @@ package opensearch
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 )
 
 // @SDKResource("aws_opensearch_domain", name="Domain")
+// @MovedFrom("aws_elasticsearch_domain", stateMover="moveStateFromElasticsearchDomain")
 // @Tags(identifierAttribute="id")
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
@@ -53,27 +56,22 @@ func resourceDomain() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ForceNewIf(names.AttrEngineVersion, func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-				newVersion := d.Get(names.AttrEngineVersion).(string)
-				domainName := d.Get(names.AttrDomainName).(string)
+				// Only existing domains can be upgraded in place.
+				if d.Id() == "" {
+					return false
+				}
 
 				conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
-				resp, err := conn.GetCompatibleVersions(ctx, &opensearch.GetCompatibleVersionsInput{
-					DomainName: aws.String(domainName),
-				})
+				domainName := d.Get(names.AttrDomainName).(string)
+				ok, err := isCompatibleUpgradeVersion(ctx, conn, domainName, d.Get(names.AttrEngineVersion).(string))
 				if err != nil {
 					log.Printf("[ERROR] Failed to get compatible OpenSearch versions %s", domainName)
 					return false
 				}
-				if len(resp.CompatibleVersions) != 1 {
-					return true
-				}
-				for _, targetVersion := range resp.CompatibleVersions[0].TargetVersions {
-					if targetVersion == newVersion {
-						return false
-					}
-				}
-				return true
+
+				return !ok
 			}),
+			domainUpgradeCheckCustomizeDiff,
 			customdiff.ForceNewIf("encrypt_at_rest.0.enabled", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				o, n := d.GetChange("encrypt_at_rest.0.enabled")
 				if o.(bool) && !n.(bool) {
@@ -986,6 +984,9 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// The domain configuration update and any version upgrade share the update timeout.
+		deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
 		input := opensearch.UpdateDomainConfigInput{
 			DomainName: aws.String(d.Get(names.AttrDomainName).(string)),
 		}
@@ -1123,14 +1124,16 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): %s", d.Id(), err)
 		}
 
-		if err := waitForDomainUpdate(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := waitForDomainUpdate(ctx, conn, d.Get(names.AttrDomainName).(string), deadline.Remaining()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): waiting for completion: %s", d.Id(), err)
 		}
 
 		if d.HasChange(names.AttrEngineVersion) {
+			domainName, targetVersion := d.Get(names.AttrDomainName).(string), d.Get(names.AttrEngineVersion).(string)
+
 			upgradeInput := opensearch.UpgradeDomainInput{
-				DomainName:    aws.String(d.Get(names.AttrDomainName).(string)),
-				TargetVersion: aws.String(d.Get(names.AttrEngineVersion).(string)),
+				DomainName:    aws.String(domainName),
+				TargetVersion: aws.String(targetVersion),
 			}
 
 			_, err := conn.UpgradeDomain(ctx, &upgradeInput)
@@ -1138,7 +1141,7 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 				return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): upgrading: %s", d.Id(), err)
 			}
 
-			if _, err := waitUpgradeSucceeded(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitUpgradeSucceeded(ctx, conn, domainName, deadline.Remaining()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): upgrading: waiting for completion: %s", d.Id(), err)
 			}
 		}
@@ -1196,6 +1199,110 @@ func findDomainByName(ctx context.Context, conn *opensearch.Client, name string)
 	return output.DomainStatus, nil
 }
 
+func findLatestUpgradeHistoryByName(ctx context.Context, conn *opensearch.Client, name string) (*awstypes.UpgradeHistory, error) {
+	input := &opensearch.GetUpgradeHistoryInput{
+		DomainName: aws.String(name),
+		MaxResults: 1,
+	}
+
+	output, err := conn.GetUpgradeHistory(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.UpgradeHistories) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.UpgradeHistories[0], nil
+}
+
+// isCompatibleUpgradeVersion returns whether the domain can be upgraded in place to the specified version.
+func isCompatibleUpgradeVersion(ctx context.Context, conn *opensearch.Client, domainName, version string) (bool, error) {
+	output, err := conn.GetCompatibleVersions(ctx, &opensearch.GetCompatibleVersionsInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(output.CompatibleVersions) != 1 {
+		return false, nil
+	}
+
+	return slices.Contains(output.CompatibleVersions[0].TargetVersions, version), nil
+}
+
+// domainUpgradeCheckCustomizeDiff runs the service's pre-upgrade eligibility check (a dry run of the upgrade)
+// when engine_version is changed to a compatible version, so that incompatibilities are reported at plan time.
+func domainUpgradeCheckCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange(names.AttrEngineVersion) || !d.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+	domainName, targetVersion := d.Get(names.AttrDomainName).(string), d.Get(names.AttrEngineVersion).(string)
+
+	if ok, err := isCompatibleUpgradeVersion(ctx, conn, domainName, targetVersion); err != nil || !ok {
+		// The domain is replaced instead.
+		return nil
+	}
+
+	// CustomizeDiff also runs during apply. Don't start another check while one (e.g. from plan) is still running.
+	if output, err := conn.GetUpgradeStatus(ctx, &opensearch.GetUpgradeStatusInput{DomainName: aws.String(domainName)}); err == nil && output.StepStatus == awstypes.UpgradeStatusInProgress {
+		log.Printf("[WARN] OpenSearch Domain (%s) upgrade eligibility check skipped: %s step in progress", domainName, output.UpgradeStep)
+		return nil
+	}
+
+	input := opensearch.UpgradeDomainInput{
+		DomainName:       aws.String(domainName),
+		PerformCheckOnly: aws.Bool(true),
+		TargetVersion:    aws.String(targetVersion),
+	}
+
+	if _, err := conn.UpgradeDomain(ctx, &input); err != nil {
+		return fmt.Errorf("checking OpenSearch Domain (%s) upgrade eligibility: %w", domainName, err)
+	}
+
+	if _, err := waitUpgradeCheckSucceeded(ctx, conn, domainName, domainUpgradeCheckTimeout); err != nil {
+		// Don't block planning on a slow check. Any incompatibility is still reported when the upgrade is applied.
+		if tfresource.TimedOut(err) {
+			log.Printf("[WARN] OpenSearch Domain (%s) upgrade eligibility check did not complete within %s, continuing", domainName, domainUpgradeCheckTimeout)
+			return nil
+		}
+
+		return fmt.Errorf("checking OpenSearch Domain (%s) upgrade eligibility: waiting for completion: %w", domainName, err)
+	}
+
+	return nil
+}
+
+// upgradeHistoryError returns the issues reported by the steps of an upgrade, or nil if there are none.
+func upgradeHistoryError(apiObject *awstypes.UpgradeHistory) error {
+	var issues []string
+
+	for _, step := range apiObject.StepsList {
+		for _, issue := range step.Issues {
+			issues = append(issues, fmt.Sprintf("%s: %s", step.UpgradeStep, issue))
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(issues, "; "))
+}
+
 // inPlaceEncryptionEnableVersion returns true if, based on version, encryption
 // can be enabled in place (without ForceNew)
 func inPlaceEncryptionEnableVersion(version string) bool {
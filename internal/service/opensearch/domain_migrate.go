@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+)
+
+// moveStateFromElasticsearchDomain transforms the state of an `aws_elasticsearch_domain` resource to this resource's schema.
+// Attributes common to both resources are moved unchanged, any not present in this resource's schema are removed
+// and any new attributes are populated by the next refresh.
+func moveStateFromElasticsearchDomain(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	if v, ok := rawState["elasticsearch_version"].(string); ok && v != "" {
+		rawState["engine_version"] = fmt.Sprintf("%s_%s", awstypes.EngineTypeElasticsearch, v)
+	}
+	delete(rawState, "elasticsearch_version")
+
+	return rawState, nil
+}
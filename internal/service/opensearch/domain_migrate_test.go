@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearch_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDomainMoveStateFromElasticsearchDomain(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		rawState map[string]interface{}
+		want     map[string]interface{}
+	}{
+		{
+			testName: "empty state",
+			rawState: map[string]interface{}{},
+			want:     map[string]interface{}{},
+		},
+		{
+			testName: "elasticsearch_version",
+			rawState: map[string]interface{}{
+				names.AttrDomainName:    "test",
+				"elasticsearch_version": "7.10",
+				"kibana_endpoint":       "test.example.com/_plugin/kibana/",
+			},
+			want: map[string]interface{}{
+				names.AttrDomainName: "test",
+				"engine_version":     "Elasticsearch_7.10",
+				"kibana_endpoint":    "test.example.com/_plugin/kibana/",
+			},
+		},
+		{
+			testName: "empty elasticsearch_version",
+			rawState: map[string]interface{}{
+				names.AttrDomainName:    "test",
+				"elasticsearch_version": "",
+			},
+			want: map[string]interface{}{
+				names.AttrDomainName: "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfopensearch.MoveStateFromElasticsearchDomain(ctx, testCase.rawState, nil)
+
+			if err != nil {
+				t.Fatalf("error moving state: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ var (
 
 	EBSVolumeTypePermitsIopsInput       = ebsVolumeTypePermitsIopsInput
 	EBSVolumeTypePermitsThroughputInput = ebsVolumeTypePermitsThroughputInput
+	MoveStateFromElasticsearchDomain    = moveStateFromElasticsearchDomain
 	ParseEngineVersion                  = parseEngineVersion
 	VPCEndpointsError                   = vpcEndpointsError
 	WaitForDomainCreation               = waitForDomainCreation
@@ -46,6 +46,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			MovedFrom: []types.ServicePackageResourceMovedFrom{
+				{
+					TypeName:   "aws_elasticsearch_domain",
+					StateMover: moveStateFromElasticsearchDomain,
+				},
+			},
 		},
 		{
 			Factory:  resourceDomainPolicy,
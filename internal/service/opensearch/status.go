@@ -20,7 +20,7 @@ const (
 	configStatusExists   = "Exists"
 )
 
-func statusUpgradeStatus(ctx context.Context, conn *opensearch.Client, name string) retry.StateRefreshFunc {
+func statusUpgradeStatus(ctx context.Context, conn *opensearch.Client, name string, finalStep awstypes.UpgradeStep) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := conn.GetUpgradeStatus(ctx, &opensearch.GetUpgradeStatusInput{
 			DomainName: aws.String(name),
@@ -31,8 +31,8 @@ func statusUpgradeStatus(ctx context.Context, conn *opensearch.Client, name stri
 
 		// opensearch upgrades consist of multiple steps:
 		// https://docs.aws.amazon.com/opensearch-service/latest/developerguide/opensearch-version-migration.html
-		// Prevent false positive completion where the UpgradeStep is not the final UPGRADE step.
-		if out.StepStatus == awstypes.UpgradeStatusSucceeded && out.UpgradeStep != awstypes.UpgradeStepUpgrade {
+		// Prevent false positive completion where the UpgradeStep is not the final step.
+		if out.StepStatus == awstypes.UpgradeStatusSucceeded && out.UpgradeStep != finalStep {
 			return out, string(awstypes.UpgradeStatusInProgress), nil
 		}
 
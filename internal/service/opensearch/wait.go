@@ -19,13 +19,22 @@ import (
 const (
 	domainUpgradeSuccessMinTimeout = 10 * time.Second
 	domainUpgradeSuccessDelay      = 30 * time.Second
+	domainUpgradeCheckTimeout      = 2 * time.Minute // Plan-time eligibility check.
 )
 
 func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name string, timeout time.Duration) (*opensearch.GetUpgradeStatusOutput, error) {
+	return waitUpgradeStepSucceeded(ctx, conn, name, awstypes.UpgradeStepUpgrade, timeout)
+}
+
+func waitUpgradeCheckSucceeded(ctx context.Context, conn *opensearch.Client, name string, timeout time.Duration) (*opensearch.GetUpgradeStatusOutput, error) {
+	return waitUpgradeStepSucceeded(ctx, conn, name, awstypes.UpgradeStepPreUpgradeCheck, timeout)
+}
+
+func waitUpgradeStepSucceeded(ctx context.Context, conn *opensearch.Client, name string, finalStep awstypes.UpgradeStep, timeout time.Duration) (*opensearch.GetUpgradeStatusOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.UpgradeStatusInProgress),
 		Target:     enum.Slice(awstypes.UpgradeStatusSucceeded),
-		Refresh:    statusUpgradeStatus(ctx, conn, name),
+		Refresh:    statusUpgradeStatus(ctx, conn, name, finalStep),
 		Timeout:    timeout,
 		MinTimeout: domainUpgradeSuccessMinTimeout,
 		Delay:      domainUpgradeSuccessDelay,
@@ -34,6 +43,12 @@ func waitUpgradeSucceeded(ctx context.Context, conn *opensearch.Client, name str
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*opensearch.GetUpgradeStatusOutput); ok {
+		if status := output.StepStatus; status == awstypes.UpgradeStatusFailed || status == awstypes.UpgradeStatusSucceededWithIssues {
+			if history, herr := findLatestUpgradeHistoryByName(ctx, conn, name); herr == nil {
+				tfresource.SetLastError(err, upgradeHistoryError(history))
+			}
+		}
+
 		return output, err
 	}
 
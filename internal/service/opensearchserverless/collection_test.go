@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -61,7 +62,6 @@ func TestAccOpenSearchServerlessCollection_standbyReplicas(t *testing.T) {
 	ctx := acctest.Context(t)
 	var collection types.CollectionDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	standbyReplicas := "DISABLED"
 	resourceName := "aws_opensearchserverless_collection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,14 +75,14 @@ func TestAccOpenSearchServerlessCollection_standbyReplicas(t *testing.T) {
 		CheckDestroy:             testAccCheckCollectionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCollectionConfig_standbyReplicas(rName, standbyReplicas),
+				Config: testAccCollectionConfig_standbyReplicas(rName, string(types.StandbyReplicasDisabled)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCollectionExists(ctx, resourceName, &collection),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrType),
 					resource.TestCheckResourceAttrSet(resourceName, "collection_endpoint"),
 					resource.TestCheckResourceAttrSet(resourceName, "dashboard_endpoint"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrKMSKeyARN),
-					resource.TestCheckResourceAttr(resourceName, "standby_replicas", standbyReplicas),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", string(types.StandbyReplicasDisabled)),
 				),
 			},
 			{
@@ -90,6 +90,18 @@ func TestAccOpenSearchServerlessCollection_standbyReplicas(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccCollectionConfig_standbyReplicas(rName, string(types.StandbyReplicasEnabled)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(ctx, resourceName, &collection),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", string(types.StandbyReplicasEnabled)),
+				),
+			},
 		},
 	})
 }
@@ -42,6 +42,12 @@ func resourceAccount() *schema.Resource {
 			StateContext: resourceAccountImportState,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -66,6 +72,11 @@ func resourceAccount() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`), "must be a valid email address"),
 				),
 			},
+			"fail_if_delegated_administrator": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"govcloud_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,24 +194,8 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("govcloud_id", output.GovCloudAccountId)
 
 	if v, ok := d.GetOk("parent_id"); ok {
-		oldParentAccountID, err := findParentAccountID(ctx, conn, d.Id())
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading AWS Organizations Account (%s) parent: %s", d.Id(), err)
-		}
-
-		if newParentAccountID, oldParentAccountID := v.(string), aws.ToString(oldParentAccountID); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
-			}
-
-			_, err := conn.MoveAccount(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
-			}
+		if err := moveAccount(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -245,18 +240,8 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
 	if d.HasChange("parent_id") {
-		o, n := d.GetChange("parent_id")
-
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
-		}
-
-		_, err := conn.MoveAccount(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
+		if err := moveAccount(ctx, conn, d.Id(), d.Get("parent_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -267,13 +252,27 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
+	if d.Get("fail_if_delegated_administrator").(bool) {
+		services, err := findDelegatedServicesByAccountID(ctx, conn, d.Id())
+
+		switch {
+		case errs.IsA[*awstypes.AccountNotRegisteredException](err), errs.IsA[*awstypes.AccountNotFoundException](err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading AWS Organizations Account (%s) delegated services: %s", d.Id(), err)
+		case len(services) > 0:
+			return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): account is a delegated administrator for %d service(s), including %s", d.Id(), len(services), aws.ToString(services[0].ServicePrincipal))
+		}
+	}
+
 	close := d.Get("close_on_deletion").(bool)
 	var err error
 
 	if close {
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
-		_, err = conn.CloseAccount(ctx, &organizations.CloseAccountInput{
-			AccountId: aws.String(d.Id()),
+		_, err = tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+			return conn.CloseAccount(ctx, &organizations.CloseAccountInput{
+				AccountId: aws.String(d.Id()),
+			})
 		})
 	} else {
 		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
@@ -291,7 +290,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
+		if _, err := waitAccountClosed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
 			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -315,7 +314,56 @@ func resourceAccountImportState(ctx context.Context, d *schema.ResourceData, met
 	return []*schema.ResourceData{d}, nil
 }
 
+func moveAccount(ctx context.Context, conn *organizations.Client, id, destinationParentID string, timeout time.Duration) error {
+	// Always use the current parent as the source; MoveAccount fails if the account was moved out of band.
+	sourceParentID, err := findParentAccountID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading AWS Organizations Account (%s) parent: %w", id, err)
+	}
+
+	if aws.ToString(sourceParentID) == destinationParentID {
+		return nil
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		DestinationParentId: aws.String(destinationParentID),
+		SourceParentId:      sourceParentID,
+	}
+
+	_, err = tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, timeout, func() (interface{}, error) {
+		return conn.MoveAccount(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.DuplicateAccountException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("moving AWS Organizations Account (%s) from %s to %s: %w", id, aws.ToString(sourceParentID), destinationParentID, err)
+	}
+
+	return nil
+}
+
 func findAccountByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
+	output, err := findAccountIncludingSuspendedByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.AccountStatusSuspended {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
+func findAccountIncludingSuspendedByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
 	input := &organizations.DescribeAccountInput{
 		AccountId: aws.String(id),
 	}
@@ -337,13 +385,6 @@ func findAccountByID(ctx context.Context, conn *organizations.Client, id string)
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	if status := output.Account.Status; status == awstypes.AccountStatusSuspended {
-		return nil, &retry.NotFoundError{
-			Message:     string(status),
-			LastRequest: input,
-		}
-	}
-
 	return output.Account, nil
 }
 
@@ -455,7 +496,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Client, id stri
 
 func statusAccountStatus(ctx context.Context, conn *organizations.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findAccountByID(ctx, conn, id)
+		output, err := findAccountIncludingSuspendedByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -469,13 +510,13 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Client, id str
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
+func waitAccountClosed(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) (*awstypes.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AccountStatusPendingClosure, awstypes.AccountStatusActive),
-		Target:       []string{},
+		Target:       enum.Slice(awstypes.AccountStatusSuspended),
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
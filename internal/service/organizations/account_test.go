@@ -28,6 +28,7 @@ func testAccAccountImportStep(n string) resource.TestStep {
 		ImportStateVerifyIgnore: []string{
 			"close_on_deletion",
 			"create_govcloud",
+			"fail_if_delegated_administrator",
 			"govcloud_id",
 		},
 	}
@@ -112,6 +113,40 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 	})
 }
 
+func testAccAccount_failIfDelegatedAdministrator(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v awstypes.Account
+	resourceName := "aws_organizations_account.test"
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_failIfDelegatedAdministrator(name, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "fail_if_delegated_administrator", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			testAccAccountImportStep(resourceName),
+		},
+	})
+}
+
 func testAccAccount_ParentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
@@ -301,6 +336,17 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_failIfDelegatedAdministrator(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name                            = %[1]q
+  email                           = %[2]q
+  close_on_deletion               = true
+  fail_if_delegated_administrator = true
+}
+`, name, email)
+}
+
 func testAccAccountConfig_parentId1(name, email string) string {
 	return fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
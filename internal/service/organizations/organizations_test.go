@@ -38,11 +38,12 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource_delegatedAdministrator": testAccOrganizationDataSource_delegatedAdministrator,
 		},
 		"Account": {
-			acctest.CtBasic:   testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
-			"GovCloud":        testAccAccount_govCloud,
+			acctest.CtBasic:                testAccAccount_basic,
+			"CloseOnDeletion":              testAccAccount_CloseOnDeletion,
+			"FailIfDelegatedAdministrator": testAccAccount_failIfDelegatedAdministrator,
+			"ParentId":                     testAccAccount_ParentID,
+			"Tags":                         testAccAccount_Tags,
+			"GovCloud":                     testAccAccount_govCloud,
 		},
 		"OrganizationalUnit": {
 			acctest.CtBasic:                      testAccOrganizationalUnit_basic,
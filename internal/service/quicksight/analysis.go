@@ -112,6 +112,7 @@ func resourceAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"validation_strategy": quicksightschema.ValidationStrategySchema(),
 			}
 		},
 
@@ -156,6 +157,10 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ThemeArn = aws.String(v)
 	}
 
+	if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+	}
+
 	_, err := conn.CreateAnalysis(ctx, input)
 
 	if err != nil {
@@ -233,7 +238,7 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "validation_strategy") {
 		input := &quicksight.UpdateAnalysisInput{
 			AnalysisId:   aws.String(analysisID),
 			AwsAccountId: aws.String(awsAccountID),
@@ -254,6 +259,10 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ThemeArn = aws.String(v)
 		}
 
+		if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+		}
+
 		_, err := conn.UpdateAnalysis(ctx, input)
 
 		if err != nil {
@@ -293,6 +293,41 @@ func TestAccQuickSightAnalysis_theme(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_validationStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var analysis awstypes.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig_validationStrategy(rId, rName, string(awstypes.ValidationStrategyModeLenient)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "analysis_id", rId),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceStatusCreationSuccessful)),
+					resource.TestCheckResourceAttr(resourceName, "validation_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validation_strategy.0.mode", string(awstypes.ValidationStrategyModeLenient)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation_strategy"},
+			},
+		},
+	})
+}
+
 func testAccCheckAnalysisDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
@@ -822,3 +857,40 @@ resource "aws_quicksight_analysis" "test" {
 }
 `, rId, rName, themeArn))
 }
+
+func testAccAnalysisConfig_validationStrategy(rId, rName, mode string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+
+  validation_strategy {
+    mode = %[3]q
+  }
+}
+`, rId, rName, mode))
+}
@@ -106,6 +106,7 @@ func resourceDashboard() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"validation_strategy": quicksightschema.ValidationStrategySchema(),
 				"version_description": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -163,6 +164,10 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.SourceEntity = quicksightschema.ExpandDashboardSourceEntity(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("version_description"); ok {
 		input.VersionDescription = aws.String(v.(string))
 	}
@@ -251,7 +256,7 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "validation_strategy") {
 		inputUD := &quicksight.UpdateDashboardInput{
 			AwsAccountId:       aws.String(awsAccountID),
 			DashboardId:        aws.String(dashboardID),
@@ -273,6 +278,10 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			inputUD.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 		}
 
+		if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			inputUD.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+		}
+
 		output, err := conn.UpdateDashboard(ctx, inputUD)
 
 		if err != nil {
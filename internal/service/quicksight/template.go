@@ -95,6 +95,7 @@ func resourceTemplate() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
+				"validation_strategy": quicksightschema.ValidationStrategySchema(),
 				"version_description": {
 					Type:         schema.TypeString,
 					Required:     true,
@@ -140,6 +141,10 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.SourceEntity = quicksightschema.ExpandTemplateSourceEntity(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("version_description"); ok {
 		input.VersionDescription = aws.String(v.(string))
 	}
@@ -224,7 +229,7 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "validation_strategy") {
 		input := &quicksight.UpdateTemplateInput{
 			AwsAccountId:       aws.String(awsAccountID),
 			Name:               aws.String(d.Get(names.AttrName).(string)),
@@ -239,6 +244,10 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Definition = quicksightschema.ExpandTemplateDefinition(d.Get("definition").([]interface{}))
 		}
 
+		if v, ok := d.GetOk("validation_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ValidationStrategy = quicksightschema.ExpandValidationStrategy(v.([]interface{}))
+		}
+
 		_, err := conn.UpdateTemplate(ctx, input)
 
 		if err != nil {
@@ -6,15 +6,22 @@ package ram
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ram"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -46,10 +53,30 @@ func resourceResourceShare() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"accepter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPrincipal: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"allow_external_principals": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -63,11 +90,31 @@ func resourceResourceShare() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"permission": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"permission_arns"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrVersion: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"permission_arns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"permission"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
@@ -96,6 +143,12 @@ func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, me
 		input.PermissionArns = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionArns = tfslices.ApplyToAll(expandResourceSharePermissions(v.(*schema.Set).List()), func(v resourceSharePermission) string {
+			return v.arn
+		})
+	}
+
 	output, err := conn.CreateResourceShare(ctx, input)
 
 	if err != nil {
@@ -116,6 +169,27 @@ func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) create: %s", d.Id(), err)
 	}
 
+	// Pin any permission versions. The share was created with each permission's default version.
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		for _, permission := range expandResourceSharePermissions(v.(*schema.Set).List()) {
+			if permission.version == 0 {
+				continue
+			}
+
+			if err := associateResourceSharePermission(ctx, conn, d.Id(), permission); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("accepter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if err := associateAndAcceptResourceShare(ctx, meta.(*conns.AWSClient), d.Id(), tfMap[names.AttrPrincipal].(string), tfMap[names.AttrRoleARN].(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceResourceShareRead(ctx, d, meta)...)
 }
 
@@ -162,6 +236,28 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 	d.Set("permission_arns", permissionARNs)
 
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		if err := d.Set("permission", flattenResourceSharePermissions(permissions, expandResourceSharePermissions(v.(*schema.Set).List()))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting permission: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("accepter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		principal := v.([]interface{})[0].(map[string]interface{})[names.AttrPrincipal].(string)
+
+		// Clear the accepter if the recipient account is no longer associated, e.g. it left the share, so that it is associated again.
+		association, err := findPrincipalAssociationByTwoPartKey(ctx, conn, d.Id(), principal)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("accepter", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) principal (%s) association: %s", d.Id(), principal, err)
+		case association.Status != awstypes.ResourceShareAssociationStatusAssociated:
+			d.Set("accepter", nil)
+		}
+	}
+
 	return diags
 }
 
@@ -183,6 +279,67 @@ func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := expandResourceSharePermissions(ns.Difference(os).List()), expandResourceSharePermissions(os.Difference(ns).List())
+
+		// Associate first as each resource type in the share must have a permission.
+		for _, permission := range add {
+			if err := associateResourceSharePermission(ctx, conn, d.Id(), permission); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		for _, permission := range del {
+			if slices.ContainsFunc(add, func(v resourceSharePermission) bool { return v.arn == permission.arn }) {
+				continue
+			}
+
+			input := &ram.DisassociateResourceSharePermissionInput{
+				ClientToken:      aws.String(sdkid.UniqueId()),
+				PermissionArn:    aws.String(permission.arn),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			_, err := conn.DisassociateResourceSharePermission(ctx, input)
+
+			// The permission was replaced by an associated permission for the same resource type.
+			if errs.IsA[*awstypes.UnknownResourceException](err) || errs.IsA[*awstypes.InvalidParameterException](err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating RAM Resource Share (%s) permission (%s): %s", d.Id(), permission.arn, err)
+			}
+		}
+	}
+
+	if d.HasChange("accepter") {
+		o, n := d.GetChange("accepter")
+
+		var oldPrincipal, newPrincipal, newRoleARN string
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			oldPrincipal = v[0].(map[string]interface{})[names.AttrPrincipal].(string)
+		}
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			newPrincipal, newRoleARN = tfMap[names.AttrPrincipal].(string), tfMap[names.AttrRoleARN].(string)
+		}
+
+		if oldPrincipal != "" && oldPrincipal != newPrincipal {
+			if err := disassociateResourceSharePrincipal(ctx, conn, d.Id(), oldPrincipal); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if newPrincipal != "" {
+			if err := associateAndAcceptResourceShare(ctx, meta.(*conns.AWSClient), d.Id(), newPrincipal, newRoleARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceResourceShareRead(ctx, d, meta)...)
 }
 
@@ -318,3 +475,171 @@ func waitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.Client,
 
 	return nil, err
 }
+
+type resourceSharePermission struct {
+	arn     string
+	version int
+}
+
+func expandResourceSharePermissions(tfList []interface{}) []resourceSharePermission {
+	var apiObjects []resourceSharePermission
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := resourceSharePermission{}
+
+		if v, ok := tfMap[names.AttrARN].(string); ok && v != "" {
+			apiObject.arn = v
+		}
+
+		if v, ok := tfMap[names.AttrVersion].(int); ok {
+			apiObject.version = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenResourceSharePermissions flattens the share's permissions.
+// A permission's version is only set if the configuration pins it.
+func flattenResourceSharePermissions(apiObjects []awstypes.ResourceSharePermissionSummary, configured []resourceSharePermission) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		arn := aws.ToString(apiObject.Arn)
+		tfMap := map[string]interface{}{
+			names.AttrARN: arn,
+		}
+
+		if slices.ContainsFunc(configured, func(v resourceSharePermission) bool { return v.arn == arn && v.version != 0 }) {
+			if v, err := strconv.Atoi(aws.ToString(apiObject.Version)); err == nil {
+				tfMap[names.AttrVersion] = v
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func associateResourceSharePermission(ctx context.Context, conn *ram.Client, resourceShareARN string, permission resourceSharePermission) error {
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permission.arn),
+		ResourceShareArn: aws.String(resourceShareARN),
+		// Replace any existing permission for the same resource type, e.g. an AWS managed permission.
+		Replace: aws.Bool(true),
+	}
+
+	if permission.version != 0 {
+		input.PermissionVersion = aws.Int32(int32(permission.version))
+	}
+
+	_, err := conn.AssociateResourceSharePermission(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("associating RAM Resource Share (%s) permission (%s): %w", resourceShareARN, permission.arn, err)
+	}
+
+	return nil
+}
+
+// associateAndAcceptResourceShare shares the resource share with the specified AWS account and accepts the resulting
+// invitation in that account using the specified IAM role.
+func associateAndAcceptResourceShare(ctx context.Context, meta *conns.AWSClient, resourceShareARN, principal, roleARN string, timeout time.Duration) error {
+	conn := meta.RAMClient(ctx)
+
+	input := &ram.AssociateResourceShareInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Principals:       []string{principal},
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	_, err := conn.AssociateResourceShare(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("associating RAM Resource Share (%s) with principal (%s): %w", resourceShareARN, principal, err)
+	}
+
+	accepterConn := newAccepterRAMClient(ctx, meta, roleARN)
+
+	maybeInvitation, err := findMaybeResourceShareInvitationByResourceShareARNAndStatus(ctx, accepterConn, resourceShareARN, string(awstypes.ResourceShareInvitationStatusPending))
+
+	if err != nil {
+		return fmt.Errorf("reading pending RAM Resource Share (%s) invitation in account (%s): %w", resourceShareARN, principal, err)
+	}
+
+	// No invitation is sent when sharing within an AWS Organization with RAM sharing enabled.
+	if maybeInvitation.IsSome() {
+		invitationARN := aws.ToString(maybeInvitation.MustUnwrap().ResourceShareInvitationArn)
+		input := &ram.AcceptResourceShareInvitationInput{
+			ClientToken:                aws.String(sdkid.UniqueId()),
+			ResourceShareInvitationArn: aws.String(invitationARN),
+		}
+
+		_, err := accepterConn.AcceptResourceShareInvitation(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("accepting RAM Resource Share (%s) invitation (%s): %w", resourceShareARN, invitationARN, err)
+		}
+
+		if _, err := waitResourceShareInvitationAccepted(ctx, accepterConn, invitationARN, timeout); err != nil {
+			return fmt.Errorf("waiting for RAM Resource Share (%s) invitation (%s) accept: %w", resourceShareARN, invitationARN, err)
+		}
+	}
+
+	if _, err := waitPrincipalAssociationCreated(ctx, conn, resourceShareARN, principal); err != nil {
+		return fmt.Errorf("waiting for RAM Resource Share (%s) principal (%s) association: %w", resourceShareARN, principal, err)
+	}
+
+	return nil
+}
+
+func disassociateResourceSharePrincipal(ctx context.Context, conn *ram.Client, resourceShareARN, principal string) error {
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Principals:       []string{principal},
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	_, err := conn.DisassociateResourceShare(ctx, input)
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disassociating RAM Resource Share (%s) principal (%s): %w", resourceShareARN, principal, err)
+	}
+
+	if _, err := waitPrincipalAssociationDeleted(ctx, conn, resourceShareARN, principal); err != nil {
+		return fmt.Errorf("waiting for RAM Resource Share (%s) principal (%s) disassociation: %w", resourceShareARN, principal, err)
+	}
+
+	return nil
+}
+
+// newAccepterRAMClient returns a RAM client that uses credentials for the specified IAM role, typically in the recipient account.
+func newAccepterRAMClient(ctx context.Context, meta *conns.AWSClient, roleARN string) *ram.Client {
+	cfg := meta.AwsConfig(ctx)
+	endpoints := meta.Endpoints(ctx)
+
+	stsConn := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if v := endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsConn, roleARN))
+
+	return ram.NewFromConfig(cfg,
+		ram.WithEndpointResolverV2(newEndpointResolverV2()),
+		withBaseEndpoint(endpoints[names.RAM]),
+	)
+}
@@ -84,6 +84,79 @@ func TestAccRAMResourceShare_permission(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShare_permissionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourceShare awstypes.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_permissionBlock(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						names.AttrVersion: "0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceShareConfig_permissionBlock(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						names.AttrVersion: "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRAMResourceShare_accepter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourceShare awstypes.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_accepter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "accepter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "accepter.0.principal", "data.aws_caller_identity.accepter", names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "accepter.0.role_arn", "aws_iam_role.accepter", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"accepter"},
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShare_allowExternalPrincipals(t *testing.T) {
 	ctx := acctest.Context(t)
 	var resourceShare1, resourceShare2 awstypes.ResourceShare
@@ -324,3 +397,76 @@ resource "aws_ram_resource_share" "test" {
 }
 `, rName)
 }
+
+func testAccResourceShareConfig_permissionBlock(rName string, version int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+
+  permission {
+    arn     = "arn:${data.aws_partition.current.partition}:ram::aws:permission/AWSRAMBlankEndEntityCertificateAPICSRPassthroughIssuanceCertificateAuthority"
+    version = %[2]d == 0 ? null : %[2]d
+  }
+}
+`, rName, version)
+}
+
+func testAccResourceShareConfig_accepter(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "accepter" {
+  provider = "awsalternate"
+}
+
+resource "aws_iam_role" "accepter" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "accepter" {
+  provider = "awsalternate"
+
+  name = %[1]q
+  role = aws_iam_role.accepter.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "ram:AcceptResourceShareInvitation",
+        "ram:GetResourceShareInvitations",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_ram_resource_share" "test" {
+  name                      = %[1]q
+  allow_external_principals = true
+
+  accepter {
+    principal = data.aws_caller_identity.accepter.account_id
+    role_arn  = aws_iam_role.accepter.arn
+  }
+
+  depends_on = [aws_iam_role_policy.accepter]
+}
+`, rName))
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Default View")
+func newDefaultViewResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &defaultViewResource{}, nil
+}
+
+type defaultViewResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*defaultViewResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_default_view"
+}
+
+func (r *defaultViewResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *defaultViewResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data defaultViewResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	input := &resourceexplorer2.AssociateDefaultViewInput{
+		ViewArn: fwflex.StringFromFramework(ctx, data.ViewARN),
+	}
+
+	_, err := conn.AssociateDefaultView(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resource Explorer Default View (%s)", data.ViewARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// The default view is a per-Region setting.
+	data.ID = types.StringValue(r.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *defaultViewResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data defaultViewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	viewARN, err := findDefaultViewARNNotEmpty(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Default View (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ViewARN = fwtypes.ARNValue(viewARN)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *defaultViewResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new defaultViewResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	input := &resourceexplorer2.AssociateDefaultViewInput{
+		ViewArn: fwflex.StringFromFramework(ctx, new.ViewARN),
+	}
+
+	_, err := conn.AssociateDefaultView(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Default View (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *defaultViewResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data defaultViewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	tflog.Debug(ctx, "deleting Resource Explorer Default View", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+	_, err := conn.DisassociateDefaultView(ctx, &resourceexplorer2.DisassociateDefaultViewInput{})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.UnauthorizedException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Default View (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type defaultViewResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	ViewARN fwtypes.ARN  `tfsdk:"view_arn"`
+}
+
+func findDefaultViewARNNotEmpty(ctx context.Context, conn *resourceexplorer2.Client) (string, error) {
+	output, err := findDefaultViewARN(ctx, conn)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.UnauthorizedException](err) {
+		return "", &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == "" {
+		return "", &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
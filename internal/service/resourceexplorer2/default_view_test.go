@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourceexplorer2 "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDefaultView_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view.test"
+	viewResourceName := "aws_resourceexplorer2_view.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", viewResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDefaultView_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultViewExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfresourceexplorer2.ResourceDefaultView, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDefaultView_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", "aws_resourceexplorer2_view.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccDefaultViewConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", "aws_resourceexplorer2_view.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultViewDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourceexplorer2_default_view" {
+				continue
+			}
+
+			_, err := tfresourceexplorer2.FindDefaultViewARN(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resource Explorer Default View %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDefaultViewExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client(ctx)
+
+		output, err := tfresourceexplorer2.FindDefaultViewARN(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := output, rs.Primary.Attributes["view_arn"]; got != want {
+			return fmt.Errorf("Resource Explorer Default View is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDefaultViewConfig_basic(rName, defaultViewName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourceexplorer2_view" "test1" {
+  name = "%[1]s-1"
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+
+resource "aws_resourceexplorer2_view" "test2" {
+  name = "%[1]s-2"
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+
+resource "aws_resourceexplorer2_default_view" "test" {
+  view_arn = aws_resourceexplorer2_view.%[2]s.arn
+}
+`, rName, defaultViewName)
+}
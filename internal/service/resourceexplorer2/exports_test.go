@@ -5,9 +5,11 @@ package resourceexplorer2
 
 // Exports for use in tests only.
 var (
-	ResourceIndex = newIndexResource
-	ResourceView  = newViewResource
+	ResourceDefaultView = newDefaultViewResource
+	ResourceIndex       = newIndexResource
+	ResourceView        = newViewResource
 
-	FindIndex     = findIndex
-	FindViewByARN = findViewByARN
+	FindDefaultViewARN = findDefaultViewARNNotEmpty
+	FindIndex          = findIndex
+	FindViewByARN      = findViewByARN
 )
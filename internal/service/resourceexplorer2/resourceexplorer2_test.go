@@ -11,6 +11,11 @@ import (
 
 func TestAccResourceExplorer2_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultView": {
+			acctest.CtBasic:      testAccDefaultView_basic,
+			acctest.CtDisappears: testAccDefaultView_disappears,
+			"update":             testAccDefaultView_update,
+		},
 		"Index": {
 			acctest.CtBasic:      testAccIndex_basic,
 			acctest.CtDisappears: testAccIndex_disappears,
@@ -18,7 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -63,7 +63,9 @@ func (r *viewResource) Schema(ctx context.Context, request resource.SchemaReques
 			"default_view": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
-				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			names.AttrID: framework.IDAttribute(),
 			names.AttrName: schema.StringAttribute{
@@ -146,6 +148,9 @@ func (r *viewResource) Create(ctx context.Context, request resource.CreateReques
 	data.Scope = types.StringValue(aws.ToString(output.View.Scope))
 	data.ViewARN = types.StringValue(arn)
 	data.setID()
+	if data.DefaultView.IsUnknown() {
+		data.DefaultView = types.BoolValue(false)
+	}
 
 	if data.DefaultView.ValueBool() {
 		input := &resourceexplorer2.AssociateDefaultViewInput{
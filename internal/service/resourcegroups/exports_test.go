@@ -5,9 +5,11 @@ package resourcegroups
 
 // Exports for use in tests only.
 var (
-	ResourceGroup    = resourceGroup
-	ResourceResource = resourceResource
+	ResourceGroup       = resourceGroup
+	ResourceResource    = resourceResource
+	ResourceTagSyncTask = resourceTagSyncTask
 
 	FindGroupByName          = findGroupByName
 	FindResourceByTwoPartKey = findResourceByTwoPartKey
+	FindTagSyncTaskByARN     = findTagSyncTaskByARN
 )
@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_resourcegroups_group", name="Group")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="resource-groups:CreateGroup;resource-groups:GetGroup;resource-groups:GetGroupConfiguration;resource-groups:GetGroupQuery;resource-groups:GetTags;resource-groups:Tag", update="resource-groups:UpdateGroup;resource-groups:UpdateGroupQuery;resource-groups:PutGroupConfiguration;resource-groups:GetGroup;resource-groups:Tag;resource-groups:Untag", delete="resource-groups:DeleteGroup")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -45,6 +46,11 @@ func resourceGroup() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"application_tag": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -80,15 +86,28 @@ func resourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"criticality": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			names.AttrDisplayName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"resource_query": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -128,6 +147,18 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:        getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("criticality"); ok {
+		input.Criticality = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrOwner); ok {
+		input.Owner = aws.String(v.(string))
+	}
+
 	waitForConfigurationAttached := false
 	if groupCfg, set := d.GetOk(names.AttrConfiguration); set {
 		// Only expand and add configuration if its set
@@ -174,9 +205,13 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.ToString(group.GroupArn)
+	d.Set("application_tag", group.ApplicationTag)
 	d.Set(names.AttrARN, arn)
+	d.Set("criticality", group.Criticality)
 	d.Set(names.AttrDescription, group.Description)
+	d.Set(names.AttrDisplayName, group.DisplayName)
 	d.Set(names.AttrName, group.Name)
+	d.Set(names.AttrOwner, group.Owner)
 
 	q, err := conn.GetGroupQuery(ctx, &resourcegroups.GetGroupQueryInput{
 		GroupName: aws.String(d.Id()),
@@ -230,10 +265,16 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "conversion between resource-query and configuration group types is not possible")
 	}
 
-	if d.HasChange(names.AttrDescription) {
+	if d.HasChanges("criticality", names.AttrDescription, names.AttrDisplayName, names.AttrOwner) {
 		input := &resourcegroups.UpdateGroupInput{
 			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			DisplayName: aws.String(d.Get(names.AttrDisplayName).(string)),
 			GroupName:   aws.String(d.Id()),
+			Owner:       aws.String(d.Get(names.AttrOwner).(string)),
+		}
+
+		if v, ok := d.GetOk("criticality"); ok {
+			input.Criticality = aws.Int32(int32(v.(int)))
 		}
 
 		_, err := conn.UpdateGroup(ctx, input)
@@ -146,6 +146,45 @@ func TestAccResourceGroupsGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_applicationGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Group
+	resourceName := "aws_resourcegroups_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_applicationGroup(rName, 1, "Display 1", "owner1@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criticality", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "Display 1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwner, "owner1@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig_applicationGroup(rName, 5, "Display 2", "owner2@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criticality", "5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "Display 2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwner, "owner2@example.com"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceGroupsGroup_Configuration(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Group
@@ -362,6 +401,24 @@ JSON
 `, rName, desc, query)
 }
 
+func testAccGroupConfig_applicationGroup(rName string, criticality int, displayName, owner string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name         = %[1]q
+  criticality  = %[2]d
+  display_name = %[3]q
+  owner        = %[4]q
+
+  resource_query {
+    query = <<JSON
+%[5]s
+JSON
+
+  }
+}
+`, rName, criticality, displayName, owner, testAccResourceGroupQueryConfig)
+}
+
 func testAccGroupConfig_tags1(rName, desc, query, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_resourcegroups_group" "test" {
@@ -35,12 +35,26 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"resource-groups:CreateGroup", "resource-groups:GetGroup", "resource-groups:GetGroupConfiguration", "resource-groups:GetGroupQuery", "resource-groups:GetTags", "resource-groups:Tag"},
+				Update: []string{"resource-groups:UpdateGroup", "resource-groups:UpdateGroupQuery", "resource-groups:PutGroupConfiguration", "resource-groups:GetGroup", "resource-groups:Tag", "resource-groups:Untag"},
+				Delete: []string{"resource-groups:DeleteGroup"},
+			},
 		},
 		{
 			Factory:  resourceResource,
 			TypeName: "aws_resourcegroups_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  resourceTagSyncTask,
+			TypeName: "aws_resourcegroups_tag_sync_task",
+			Name:     "Tag Sync Task",
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"resource-groups:StartTagSyncTask", "resource-groups:GetTagSyncTask", "iam:PassRole"},
+				Delete: []string{"resource-groups:CancelTagSyncTask"},
+			},
+		},
 	}
 }
 
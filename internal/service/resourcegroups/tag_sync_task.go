@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroups
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resourcegroups_tag_sync_task", name="Tag Sync Task")
+// @Permissions(create="resource-groups:StartTagSyncTask;resource-groups:GetTagSyncTask;iam:PassRole", delete="resource-groups:CancelTagSyncTask")
+func resourceTagSyncTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagSyncTaskCreate,
+		ReadWithoutTimeout:   resourceTagSyncTaskRead,
+		DeleteWithoutTimeout: resourceTagSyncTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// The group can be specified by name or ARN.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == d.Get("group_arn").(string) || new == d.Get("group_name").(string)
+				},
+			},
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tag_value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTagSyncTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	group := d.Get("group").(string)
+	input := &resourcegroups.StartTagSyncTaskInput{
+		Group:    aws.String(group),
+		RoleArn:  aws.String(d.Get(names.AttrRoleARN).(string)),
+		TagKey:   aws.String(d.Get("tag_key").(string)),
+		TagValue: aws.String(d.Get("tag_value").(string)),
+	}
+
+	output, err := conn.StartTagSyncTask(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tag Sync Task (%s): %s", group, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskArn))
+
+	return append(diags, resourceTagSyncTaskRead(ctx, d, meta)...)
+}
+
+func resourceTagSyncTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	output, err := findTagSyncTaskByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Tag Sync Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	if output.Status == types.TagSyncTaskStatusError {
+		diags = sdkdiag.AppendWarningf(diags, "Resource Groups Tag Sync Task (%s) is in %s status: %s", d.Id(), output.Status, aws.ToString(output.ErrorMessage))
+	}
+
+	d.Set(names.AttrARN, output.TaskArn)
+	if output.CreatedAt != nil {
+		d.Set(names.AttrCreatedAt, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	}
+	if d.Get("group").(string) == "" {
+		d.Set("group", output.GroupArn)
+	}
+	d.Set("group_arn", output.GroupArn)
+	d.Set("group_name", output.GroupName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("tag_key", output.TagKey)
+	d.Set("tag_value", output.TagValue)
+
+	return diags
+}
+
+func resourceTagSyncTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	log.Printf("[INFO] Deleting Resource Groups Tag Sync Task: %s", d.Id())
+	_, err := conn.CancelTagSyncTask(ctx, &resourcegroups.CancelTagSyncTaskInput{
+		TaskArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findTagSyncTaskByARN(ctx context.Context, conn *resourcegroups.Client, arn string) (*resourcegroups.GetTagSyncTaskOutput, error) {
+	input := &resourcegroups.GetTagSyncTaskInput{
+		TaskArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTagSyncTask(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -12,9 +12,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -176,7 +179,10 @@ func resourceHealthCheck() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceHealthCheckCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -248,6 +254,10 @@ func resourceHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta
 			alarmIdentifier.Region = awstypes.CloudWatchRegion(v.(string))
 		}
 
+		if err := checkHealthCheckAlarmExists(ctx, meta.(*conns.AWSClient), alarmIdentifier); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Route53 Health Check: %s", err)
+		}
+
 		healthCheckConfig.AlarmIdentifier = alarmIdentifier
 
 		if v, ok := d.GetOk("insufficient_data_health_status"); ok {
@@ -364,6 +374,10 @@ func resourceHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta
 				Region: awstypes.CloudWatchRegion(d.Get("cloudwatch_alarm_region").(string)),
 			}
 
+			if err := checkHealthCheckAlarmExists(ctx, meta.(*conns.AWSClient), alarmIdentifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Route53 Health Check (%s): %s", d.Id(), err)
+			}
+
 			input.AlarmIdentifier = alarmIdentifier
 		}
 
@@ -441,6 +455,61 @@ func resourceHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceHealthCheckCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch awstypes.HealthCheckType(d.Get(names.AttrType).(string)) {
+	case awstypes.HealthCheckTypeCalculated:
+		// The number of child health checks can only be counted once all are known.
+		if !d.GetRawConfig().GetAttr("child_healthchecks").IsWhollyKnown() {
+			return nil
+		}
+
+		if threshold, children := d.Get("child_health_threshold").(int), d.Get("child_healthchecks").(*schema.Set).Len(); threshold > children {
+			return fmt.Errorf("child_health_threshold (%d) must not be greater than the number of child_healthchecks (%d)", threshold, children)
+		}
+	case awstypes.HealthCheckTypeCloudwatchMetric:
+		for _, key := range []string{"cloudwatch_alarm_name", "cloudwatch_alarm_region"} {
+			if v := d.GetRawConfig().GetAttr(key); v.IsKnown() && v.IsNull() {
+				return fmt.Errorf("%s must be set for %s health checks", key, awstypes.HealthCheckTypeCloudwatchMetric)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkHealthCheckAlarmExists returns an error if the health check's CloudWatch alarm doesn't exist in the alarm's Region.
+// The check is made during apply, as the alarm is commonly created in the same apply as the health check.
+// If the caller isn't authorized to describe alarms, the alarm is assumed to exist.
+func checkHealthCheckAlarmExists(ctx context.Context, meta *conns.AWSClient, alarmIdentifier *awstypes.AlarmIdentifier) error {
+	name, region := aws.ToString(alarmIdentifier.Name), string(alarmIdentifier.Region)
+	if name == "" || region == "" {
+		return nil
+	}
+
+	conn := meta.CloudWatchClient(ctx)
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+	}
+
+	output, err := conn.DescribeAlarms(ctx, input, func(o *cloudwatch.Options) {
+		o.Region = region
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch Alarm (%s) in %s: %w", name, region, err)
+	}
+
+	if len(output.MetricAlarms) == 0 && len(output.CompositeAlarms) == 0 {
+		return fmt.Errorf("CloudWatch Alarm (%s) not found in %s", name, region)
+	}
+
+	return nil
+}
+
 func findHealthCheckByID(ctx context.Context, conn *route53.Client, id string) (*awstypes.HealthCheck, error) {
 	input := &route53.GetHealthCheckInput{
 		HealthCheckId: aws.String(id),
@@ -169,6 +169,23 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_childHealthThresholdExceedsChildren(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_childHealthThreshold(3),
+				ExpectError: regexache.MustCompile(`child_health_threshold \(3\) must not be greater than the number of child_healthchecks \(2\)`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withHealthCheckRegions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check awstypes.HealthCheck
@@ -288,6 +305,40 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_cloudWatchAlarmNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_cloudWatchAlarmNotFound,
+				ExpectError: regexache.MustCompile(`CloudWatch Alarm \(tf-acc-test-does-not-exist\) not found`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53HealthCheck_cloudWatchAlarmRegionMissing(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_cloudWatchAlarmRegionMissing,
+				ExpectError: regexache.MustCompile(`cloudwatch_alarm_region must be set for CLOUDWATCH_METRIC health checks`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withSNI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check awstypes.HealthCheck
@@ -563,6 +614,16 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccHealthCheckConfig_childHealthThreshold(threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "test" {
+  type                   = "CALCULATED"
+  child_health_threshold = %[1]d
+  child_healthchecks     = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+}
+`, threshold)
+}
+
 func testAccHealthCheckConfig_regions(regions ...string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -605,6 +666,25 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+const testAccHealthCheckConfig_cloudWatchAlarmNotFound = `
+data "aws_region" "current" {}
+
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = "tf-acc-test-does-not-exist"
+  cloudwatch_alarm_region         = data.aws_region.current.name
+  insufficient_data_health_status = "Healthy"
+}
+`
+
+const testAccHealthCheckConfig_cloudWatchAlarmRegionMissing = `
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = "tf-acc-test-does-not-exist"
+  insufficient_data_health_status = "Healthy"
+}
+`
+
 func testAccHealthCheckConfig_searchString(search string, invert bool) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
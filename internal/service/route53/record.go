@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"log"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
@@ -30,6 +32,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// A geoproximity location is exactly one of an AWS Region, a Local Zone group or a set of coordinates.
+var geoproximityLocationKeys = []string{
+	"geoproximity_routing_policy.0.aws_region",
+	"geoproximity_routing_policy.0.coordinates",
+	"geoproximity_routing_policy.0.local_zone_group",
+}
+
 // @SDKResource("aws_route53_record", name="Record")
 func resourceRecord() *schema.Resource {
 	//lintignore:R011
@@ -187,8 +196,9 @@ func resourceRecord() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"aws_region": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 						"bias": {
 							Type:         schema.TypeInt,
@@ -196,24 +206,29 @@ func resourceRecord() *schema.Resource {
 							ValidateFunc: validation.IntBetween(-99, 99),
 						},
 						"coordinates": {
-							Type: schema.TypeSet,
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"latitude": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCoordinate(90),
 									},
 									"longitude": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCoordinate(180),
 									},
 								},
 							},
-							Optional: true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 						"local_zone_group": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: geoproximityLocationKeys,
 						},
 					},
 				},
@@ -984,6 +999,26 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *awstypes.
 	return apiObject
 }
 
+// validateCoordinate validates a geoproximity latitude or longitude.
+// Values are decimal degrees with at most two decimal places, between -limit and limit.
+func validateCoordinate(limit float64) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringMatch(regexache.MustCompile(`^-?\d{1,3}(\.\d{1,2})?$`), "must be a decimal number with at most two decimal places"),
+		func(v interface{}, k string) (ws []string, errors []error) {
+			f, err := strconv.ParseFloat(v.(string), 64)
+			if err != nil {
+				return ws, errors
+			}
+
+			if f < -limit || f > limit {
+				errors = append(errors, fmt.Errorf("expected %s to be in the range (%.0f - %.0f), got %s", k, -limit, limit, v.(string)))
+			}
+
+			return ws, errors
+		},
+	)
+}
+
 // Check if the current record name contains the zone suffix.
 // If it does not, add the zone name to form a fully qualified name
 // and keep AWS happy.
@@ -771,6 +771,50 @@ func TestAccRoute53Record_Geoproximity_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_Geoproximity_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_geoproximityInvalid(`
+    aws_region       = "us-east-1"
+    local_zone_group = "us-east-1-atl-1"
+`),
+				ExpectError: regexache.MustCompile(`only one of`),
+			},
+			{
+				Config: testAccRecordConfig_geoproximityInvalid(`
+    bias = 10
+`),
+				ExpectError: regexache.MustCompile(`one of`),
+			},
+			{
+				Config: testAccRecordConfig_geoproximityInvalid(`
+    coordinates {
+      latitude  = "91.00"
+      longitude = "-74.01"
+    }
+`),
+				ExpectError: regexache.MustCompile(`to be in the range`),
+			},
+			{
+				Config: testAccRecordConfig_geoproximityInvalid(`
+    coordinates {
+      latitude  = "49.2234"
+      longitude = "-74.01"
+    }
+`),
+				ExpectError: regexache.MustCompile(`at most two decimal places`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_HealthCheckID_setIdentifierChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1, record2 awstypes.ResourceRecordSet
@@ -2204,6 +2248,26 @@ resource "aws_route53_record" "coordinates" {
 `, region, localzonegroup)
 }
 
+func testAccRecordConfig_geoproximityInvalid(location string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  name    = "www"
+  zone_id = aws_route53_zone.main.zone_id
+  type    = "CNAME"
+  ttl     = "5"
+
+  geoproximity_routing_policy {%[1]s  }
+
+  records        = ["dev.domain.test"]
+  set_identifier = "invalid"
+}
+`, location)
+}
+
 func testAccRecordConfig_latencyCNAME(firstRegion, secondRegion, thirdRegion string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53domains
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Domain Transfer")
+func newDomainTransferResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &domainTransferResource{}
+
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type domainTransferResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *domainTransferResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53domains_domain_transfer"
+}
+
+func (r *domainTransferResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrDomainName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"operation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *domainTransferResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data domainTransferResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53DomainsClient(ctx)
+
+	domainName := data.DomainName.ValueString()
+	input := &route53domains.TransferDomainToAnotherAwsAccountInput{
+		AccountId:  data.AccountID.ValueStringPointer(),
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.TransferDomainToAnotherAwsAccount(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Domains Domain (%s) transfer", domainName), err.Error())
+
+		return
+	}
+
+	// The operation remains in progress until the receiving account accepts or rejects the transfer.
+	operation, err := findOperationDetailByID(ctx, conn, aws.ToString(output.OperationId))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s) transfer", domainName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(domainName)
+	data.OperationID = fwflex.StringToFramework(ctx, output.OperationId)
+	data.Password = fwflex.StringToFramework(ctx, output.Password)
+	data.Status = fwflex.StringValueToFramework(ctx, operation.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *domainTransferResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53DomainsClient(ctx)
+
+	operation, err := findOperationDetailByID(ctx, conn, data.OperationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s) transfer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwflex.StringValueToFramework(ctx, operation.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainTransferResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53DomainsClient(ctx)
+
+	operation, err := findOperationDetailByID(ctx, conn, data.OperationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s) transfer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only a transfer that has not yet been accepted or rejected can be cancelled.
+	if !slices.Contains([]awstypes.OperationStatus{awstypes.OperationStatusSubmitted, awstypes.OperationStatusInProgress}, operation.Status) {
+		return
+	}
+
+	output, err := conn.CancelDomainTransferToAnotherAwsAccount(ctx, &route53domains.CancelDomainTransferToAnotherAwsAccountInput{
+		DomainName: data.DomainName.ValueStringPointer(),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Route 53 Domains Domain (%s) transfer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Route 53 Domains Domain (%s) transfer cancel", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type domainTransferResourceModel struct {
+	AccountID   types.String   `tfsdk:"account_id"`
+	DomainName  types.String   `tfsdk:"domain_name"`
+	ID          types.String   `tfsdk:"id"`
+	OperationID types.String   `tfsdk:"operation_id"`
+	Password    types.String   `tfsdk:"password"`
+	Status      types.String   `tfsdk:"status"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53domains_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDomainTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_DOMAIN_NAME")
+	resourceName := "aws_route53domains_domain_transfer.test"
+	accepterResourceName := "aws_route53domains_domain_transfer_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainTransferConfig_toAlternate(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPassword),
+					resource.TestCheckResourceAttr(accepterResourceName, names.AttrDomainName, domainName),
+				),
+			},
+			// Transfer the domain back so that it's left in the primary account.
+			{
+				Config: testAccDomainTransferConfig_fromAlternate(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, domainName),
+					resource.TestCheckResourceAttr(accepterResourceName, names.AttrDomainName, domainName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainTransferConfig_toAlternate(domainName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_route53domains_domain_transfer" "test" {
+  domain_name = %[1]q
+  account_id  = data.aws_caller_identity.alternate.account_id
+}
+
+resource "aws_route53domains_domain_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  domain_name = aws_route53domains_domain_transfer.test.domain_name
+  password    = aws_route53domains_domain_transfer.test.password
+}
+`, domainName))
+}
+
+func testAccDomainTransferConfig_fromAlternate(domainName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_route53domains_domain_transfer" "test" {
+  provider = "awsalternate"
+
+  domain_name = %[1]q
+  account_id  = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_route53domains_domain_transfer_accepter" "test" {
+  domain_name = aws_route53domains_domain_transfer.test.domain_name
+  password    = aws_route53domains_domain_transfer.test.password
+}
+`, domainName))
+}
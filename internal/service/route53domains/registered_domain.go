@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -35,7 +36,7 @@ func resourceRegisteredDomain() *schema.Resource {
 		CreateWithoutTimeout: resourceRegisteredDomainCreate,
 		ReadWithoutTimeout:   resourceRegisteredDomainRead,
 		UpdateWithoutTimeout: resourceRegisteredDomainUpdate,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceRegisteredDomainDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -44,6 +45,7 @@ func resourceRegisteredDomain() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -174,6 +176,11 @@ func resourceRegisteredDomain() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"delete_on_destroy": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				names.AttrDomainName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -469,7 +476,7 @@ func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChanges("admin_privacy", "billing_contact", "registrant_privacy", "tech_privacy") {
+	if d.HasChanges("admin_privacy", "billing_privacy", "registrant_privacy", "tech_privacy") {
 		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("billing_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -498,6 +505,35 @@ func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData,
 	return append(diags, resourceRegisteredDomainRead(ctx, d, meta)...)
 }
 
+func resourceRegisteredDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
+
+	if !d.Get("delete_on_destroy").(bool) {
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not deleted, removing from state", d.Id())
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Domains Domain: %s", d.Id())
+	output, err := conn.DeleteDomain(ctx, &route53domains.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if errs.IsAErrorMessageContains[*types.InvalidInput](err, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Domains Domain (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Domains Domain (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
 func hasDomainTransferLock(statusList []string) bool {
 	const (
 		eppStatusClientTransferProhibited = "clientTransferProhibited"
@@ -29,6 +29,9 @@ func TestAccRoute53Domains_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDelegationSignerRecord_basic,
 			acctest.CtDisappears: testAccDelegationSignerRecord_disappears,
 		},
+		"DomainTransfer": {
+			acctest.CtBasic: testAccDomainTransfer_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
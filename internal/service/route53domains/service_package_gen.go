@@ -22,6 +22,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDelegationSignerRecordResource,
 			Name:    "Delegation Signer Record",
 		},
+		{
+			Factory: newDomainTransferAccepterResource,
+			Name:    "Domain Transfer Accepter",
+		},
+		{
+			Factory: newDomainTransferResource,
+			Name:    "Domain Transfer",
+		},
 	}
 }
 
@@ -47,7 +47,6 @@ const (
 
 type resourceResourceAssociation struct {
 	framework.ResourceWithConfigure
-	framework.WithNoUpdate
 	framework.WithTimeouts
 	framework.WithImportByID
 }
@@ -62,9 +61,6 @@ func (r *resourceResourceAssociation) Schema(ctx context.Context, req resource.S
 			names.AttrID: framework.IDAttribute(),
 			names.AttrName: schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrOwnerID: schema.StringAttribute{
 				Computed: true,
@@ -100,6 +96,7 @@ func (r *resourceResourceAssociation) Schema(ctx context.Context, req resource.S
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Read:   true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -186,6 +183,51 @@ func (r *resourceResourceAssociation) Read(ctx context.Context, req resource.Rea
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *resourceResourceAssociation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().Route53ProfilesClient(ctx)
+
+	var plan, state resourceAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.ResourceProperties.Equal(state.ResourceProperties) {
+		in := &route53profiles.UpdateProfileResourceAssociationInput{
+			Name:                         plan.Name.ValueStringPointer(),
+			ProfileResourceAssociationId: plan.ID.ValueStringPointer(),
+			ResourceProperties:           plan.ResourceProperties.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateProfileResourceAssociation(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53Profiles, create.ErrActionUpdating, ResNameResourceAssociation, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		resourceAssociation, err := waitResourceAssociationUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53Profiles, create.ErrActionWaitingForUpdate, ResNameResourceAssociation, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(flex.Flatten(ctx, resourceAssociation, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
 func (r *resourceResourceAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	conn := r.Meta().Route53ProfilesClient(ctx)
 
@@ -245,6 +287,24 @@ func waitResourceAssociationCreated(ctx context.Context, conn *route53profiles.C
 	return nil, err
 }
 
+func waitResourceAssociationUpdated(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ProfileStatusUpdating),
+		Target:                    enum.Slice(awstypes.ProfileStatusComplete),
+		Refresh:                   statusResourceAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProfileResourceAssociation); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitResourceAssociationDeleted(ctx context.Context, conn *route53profiles.Client, id string, timeout time.Duration) (*awstypes.ProfileResourceAssociation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ProfileStatusDeleting),
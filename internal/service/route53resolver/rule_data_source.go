@@ -71,6 +71,30 @@ func dataSourceRule() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"target_ip": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrProtocol: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -134,6 +158,9 @@ func dataSourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("rule_type", rule.RuleType)
 	shareStatus := rule.ShareStatus
 	d.Set("share_status", shareStatus)
+	if err := d.Set("target_ip", flattenRuleTargetIPs(rule.TargetIps)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_ip: %s", err)
+	}
 	// https://github.com/hashicorp/terraform-provider-aws/issues/10211
 	if shareStatus != awstypes.ShareStatusSharedWithMe {
 		tags, err := listTags(ctx, conn, arn)
@@ -100,6 +100,7 @@ func TestAccRoute53ResolverRuleDataSource_resolverEndpointIdWithTags(t *testing.
 					resource.TestCheckResourceAttr(ds1ResourceName, acctest.CtTagsPercent, "2"),
 					resource.TestCheckResourceAttrPair(ds1ResourceName, "tags.Key1", resourceName, "tags.Key1"),
 					resource.TestCheckResourceAttrPair(ds1ResourceName, "tags.Key2", resourceName, "tags.Key2"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "target_ip.#", resourceName, "target_ip.#"),
 				),
 			},
 		},
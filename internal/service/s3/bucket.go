@@ -40,12 +40,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	// General timeout for S3 bucket changes to propagate.
 	// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/Welcome.html#ConsistencyModel.
 	bucketPropagationTimeout = 2 * time.Minute
+
+	// Maximum number of concurrent API calls made when reading a single bucket's configuration.
+	bucketReadConcurrency = 6
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
@@ -808,13 +812,104 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("bucket_domain_name", meta.(*conns.AWSClient).PartitionHostname(ctx, d.Id()+".s3"))
 	d.Set(names.AttrBucketPrefix, create.NamePrefixFromName(d.Id()))
 
+	// Read the bucket's sub-resources concurrently, bounded by bucketReadConcurrency.
+	// ResourceData is not safe for concurrent use so results are applied sequentially below.
+	var (
+		policyResult                   readResult[string]
+		bucketACLResult                readResult[*s3.GetBucketAclOutput]
+		corsRulesResult                readResult[[]types.CORSRule]
+		bucketWebsiteResult            readResult[*s3.GetBucketWebsiteOutput]
+		bucketVersioningResult         readResult[*s3.GetBucketVersioningOutput]
+		bucketAccelerateResult         readResult[*s3.GetBucketAccelerateConfigurationOutput]
+		bucketRequestPaymentResult     readResult[*s3.GetBucketRequestPaymentOutput]
+		loggingEnabledResult           readResult[*types.LoggingEnabled]
+		lifecycleRulesResult           readResult[[]types.LifecycleRule]
+		replicationConfigurationResult readResult[*types.ReplicationConfiguration]
+		encryptionConfigurationResult  readResult[*types.ServerSideEncryptionConfiguration]
+		objLockConfigResult            readResult[*types.ObjectLockConfiguration]
+		regionResult                   readResult[string]
+	)
+	bucket, timeout := d.Id(), d.Timeout(schema.TimeoutRead)
+	var g errgroup.Group
+	g.SetLimit(bucketReadConcurrency)
+	goRead(&g, &policyResult, func() (string, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (string, error) {
+			return findBucketPolicy(ctx, conn, bucket)
+		})
+	})
+	goRead(&g, &bucketACLResult, func() (*s3.GetBucketAclOutput, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*s3.GetBucketAclOutput, error) {
+			return findBucketACL(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &corsRulesResult, func() ([]types.CORSRule, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() ([]types.CORSRule, error) {
+			return findCORSRules(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &bucketWebsiteResult, func() (*s3.GetBucketWebsiteOutput, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*s3.GetBucketWebsiteOutput, error) {
+			return findBucketWebsite(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &bucketVersioningResult, func() (*s3.GetBucketVersioningOutput, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*s3.GetBucketVersioningOutput, error) {
+			return findBucketVersioning(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &bucketAccelerateResult, func() (*s3.GetBucketAccelerateConfigurationOutput, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*s3.GetBucketAccelerateConfigurationOutput, error) {
+			return findBucketAccelerateConfiguration(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &bucketRequestPaymentResult, func() (*s3.GetBucketRequestPaymentOutput, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*s3.GetBucketRequestPaymentOutput, error) {
+			return findBucketRequestPayment(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &loggingEnabledResult, func() (*types.LoggingEnabled, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*types.LoggingEnabled, error) {
+			return findLoggingEnabled(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &lifecycleRulesResult, func() ([]types.LifecycleRule, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() ([]types.LifecycleRule, error) {
+			output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, "")
+
+			if err != nil {
+				return nil, err
+			}
+
+			return output.Rules, nil
+		})
+	})
+	goRead(&g, &replicationConfigurationResult, func() (*types.ReplicationConfiguration, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*types.ReplicationConfiguration, error) {
+			return findReplicationConfiguration(ctx, conn, bucket)
+		})
+	})
+	goRead(&g, &encryptionConfigurationResult, func() (*types.ServerSideEncryptionConfiguration, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*types.ServerSideEncryptionConfiguration, error) {
+			return findServerSideEncryptionConfiguration(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &objLockConfigResult, func() (*types.ObjectLockConfiguration, error) {
+		return retryWhenNoSuchBucketError(ctx, timeout, func() (*types.ObjectLockConfiguration, error) {
+			return findObjectLockConfiguration(ctx, conn, bucket, "")
+		})
+	})
+	goRead(&g, &regionResult, func() (string, error) {
+		return manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
+			o.UsePathStyle = meta.(*conns.AWSClient).S3UsePathStyle(ctx)
+		})
+	})
+	_ = g.Wait() // Errors are returned via each readResult.
+
 	//
 	// Bucket Policy.
 	//
 	// Read the policy if configured outside this resource e.g. with aws_s3_bucket_policy resource.
-	policy, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (string, error) {
-		return findBucketPolicy(ctx, conn, d.Id())
-	})
+	policy, err := policyResult.output, policyResult.err
 
 	// The call to HeadBucket above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -842,9 +937,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket ACL.
 	//
-	bucketACL, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketAclOutput, error) {
-		return findBucketACL(ctx, conn, d.Id(), "")
-	})
+	bucketACL, err := bucketACLResult.output, bucketACLResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -866,9 +959,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket CORS Configuration.
 	//
-	corsRules, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() ([]types.CORSRule, error) {
-		return findCORSRules(ctx, conn, d.Id(), "")
-	})
+	corsRules, err := corsRulesResult.output, corsRulesResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -890,9 +981,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Website Configuration.
 	//
-	bucketWebsite, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketWebsiteOutput, error) {
-		return findBucketWebsite(ctx, conn, d.Id(), "")
-	})
+	bucketWebsite, err := bucketWebsiteResult.output, bucketWebsiteResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -918,9 +1007,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Versioning.
 	//
-	bucketVersioning, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketVersioningOutput, error) {
-		return findBucketVersioning(ctx, conn, d.Id(), "")
-	})
+	bucketVersioning, err := bucketVersioningResult.output, bucketVersioningResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -942,9 +1029,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Accelerate Configuration.
 	//
-	bucketAccelerate, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketAccelerateConfigurationOutput, error) {
-		return findBucketAccelerateConfiguration(ctx, conn, d.Id(), "")
-	})
+	bucketAccelerate, err := bucketAccelerateResult.output, bucketAccelerateResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -964,9 +1049,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Request Payment Configuration.
 	//
-	bucketRequestPayment, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketRequestPaymentOutput, error) {
-		return findBucketRequestPayment(ctx, conn, d.Id(), "")
-	})
+	bucketRequestPayment, err := bucketRequestPaymentResult.output, bucketRequestPaymentResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -986,9 +1069,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Logging.
 	//
-	loggingEnabled, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.LoggingEnabled, error) {
-		return findLoggingEnabled(ctx, conn, d.Id(), "")
-	})
+	loggingEnabled, err := loggingEnabledResult.output, loggingEnabledResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1010,15 +1091,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Lifecycle Configuration.
 	//
-	lifecycleRules, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() ([]types.LifecycleRule, error) {
-		output, err := findBucketLifecycleConfiguration(ctx, conn, d.Id(), "")
-
-		if err != nil {
-			return nil, err
-		}
-
-		return output.Rules, nil
-	})
+	lifecycleRules, err := lifecycleRulesResult.output, lifecycleRulesResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1040,9 +1113,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Replication Configuration.
 	//
-	replicationConfiguration, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ReplicationConfiguration, error) {
-		return findReplicationConfiguration(ctx, conn, d.Id())
-	})
+	replicationConfiguration, err := replicationConfigurationResult.output, replicationConfigurationResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1064,9 +1135,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Server-side Encryption Configuration.
 	//
-	encryptionConfiguration, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ServerSideEncryptionConfiguration, error) {
-		return findServerSideEncryptionConfiguration(ctx, conn, d.Id(), "")
-	})
+	encryptionConfiguration, err := encryptionConfigurationResult.output, encryptionConfigurationResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1088,9 +1157,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Object Lock Configuration.
 	//
-	objLockConfig, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ObjectLockConfiguration, error) {
-		return findObjectLockConfiguration(ctx, conn, d.Id(), "")
-	})
+	objLockConfig, err := objLockConfigResult.output, objLockConfigResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1119,9 +1186,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Region etc.
 	//
-	region, err := manager.GetBucketRegion(ctx, conn, d.Id(), func(o *s3.Options) {
-		o.UsePathStyle = meta.(*conns.AWSClient).S3UsePathStyle(ctx)
-	})
+	region, err := regionResult.output, regionResult.err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1650,6 +1715,21 @@ func findBucketRegion(ctx context.Context, awsClient *conns.AWSClient, bucket st
 	return region, nil
 }
 
+// readResult holds the output of a single concurrent read.
+type readResult[T any] struct {
+	output T
+	err    error
+}
+
+// goRead runs f on g, storing its output in r.
+func goRead[T any](g *errgroup.Group, r *readResult[T], f func() (T, error)) {
+	g.Go(func() error {
+		r.output, r.err = f()
+
+		return nil
+	})
+}
+
 func retryWhenNoSuchBucketError[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return f()
@@ -268,6 +268,9 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -288,10 +291,6 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
 	}, errCodeNoSuchBucket)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
 	}
@@ -313,6 +312,9 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 
 	const (
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
@@ -375,6 +377,9 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -414,6 +419,9 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
 
 	input := &s3.DeleteBucketLifecycleInput{
 		Bucket: aws.String(bucket),
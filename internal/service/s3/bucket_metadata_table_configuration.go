@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_metadata_table_configuration", name="Bucket Metadata Table Configuration")
+func newBucketMetadataTableConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketMetadataTableConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type bucketMetadataTableConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*bucketMetadataTableConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket_metadata_table_configuration"
+}
+
+func (r *bucketMetadataTableConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata_table_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metadataTableConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3tables_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TablesDestinationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"table_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"table_bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"table_namespace": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *bucketMetadataTableConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	configurationData, diags := data.MetadataTableConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	destinationData, diags := configurationData.S3TablesDestination.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	input := &s3.CreateBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+		MetadataTableConfiguration: &awstypes.MetadataTableConfiguration{
+			S3TablesDestination: &awstypes.S3TablesDestination{
+				TableBucketArn: fwflex.StringFromFramework(ctx, destinationData.TableBucketARN),
+				TableName:      fwflex.StringFromFramework(ctx, destinationData.TableName),
+			},
+		},
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateBucketMetadataTableConfiguration(ctx, input)
+	}, errCodeNoSuchBucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Metadata Table Configuration", bucket), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := waitBucketMetadataTableConfigurationCreated(ctx, conn, bucket, expectedBucketOwner, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket Metadata Table Configuration (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataTableConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	output, err := findBucketMetadataTableConfiguration(ctx, conn, data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Metadata Table Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataTableConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketMetadataTableConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	input := &s3.DeleteBucketMetadataTableConfigurationInput{
+		Bucket: fwflex.StringFromFramework(ctx, data.Bucket),
+	}
+	if expectedBucketOwner := data.ExpectedBucketOwner.ValueString(); expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketMetadataTableConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableConfigurationNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket Metadata Table Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	input := &s3.GetBucketMetadataTableConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketMetadataTableConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataTableConfigurationNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GetBucketMetadataTableConfigurationResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GetBucketMetadataTableConfigurationResult, nil
+}
+
+const (
+	metadataTableConfigurationStatusActive   = "ACTIVE"
+	metadataTableConfigurationStatusCreating = "CREATING"
+	metadataTableConfigurationStatusFailed   = "FAILED"
+)
+
+func statusBucketMetadataTableConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitBucketMetadataTableConfigurationCreated(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, timeout time.Duration) (*awstypes.GetBucketMetadataTableConfigurationResult, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{metadataTableConfigurationStatusCreating},
+		Target:  []string{metadataTableConfigurationStatusActive},
+		Refresh: statusBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GetBucketMetadataTableConfigurationResult); ok {
+		if v := output.Error; v != nil && aws.ToString(output.Status) == metadataTableConfigurationStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type bucketMetadataTableConfigurationResourceModel struct {
+	Bucket                     types.String                                                     `tfsdk:"bucket"`
+	ExpectedBucketOwner        types.String                                                     `tfsdk:"expected_bucket_owner"`
+	ID                         types.String                                                     `tfsdk:"id"`
+	MetadataTableConfiguration fwtypes.ListNestedObjectValueOf[metadataTableConfigurationModel] `tfsdk:"metadata_table_configuration"`
+	Status                     types.String                                                     `tfsdk:"status"`
+	Timeouts                   timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+func (data *bucketMetadataTableConfigurationResourceModel) InitFromID() error {
+	bucket, expectedBucketOwner, err := ParseResourceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Bucket = types.StringValue(bucket)
+	data.ExpectedBucketOwner = fwflex.StringValueToFramework(context.Background(), expectedBucketOwner)
+
+	return nil
+}
+
+func (data *bucketMetadataTableConfigurationResourceModel) setID() {
+	data.ID = types.StringValue(CreateResourceID(data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()))
+}
+
+func (data *bucketMetadataTableConfigurationResourceModel) flatten(ctx context.Context, apiObject *awstypes.GetBucketMetadataTableConfigurationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject.MetadataTableConfigurationResult == nil || apiObject.MetadataTableConfigurationResult.S3TablesDestinationResult == nil {
+		diags.AddError("flattening S3 Bucket Metadata Table Configuration", "empty S3 Tables destination")
+
+		return diags
+	}
+
+	destination := apiObject.MetadataTableConfigurationResult.S3TablesDestinationResult
+	data.MetadataTableConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &metadataTableConfigurationModel{
+		S3TablesDestination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3TablesDestinationModel{
+			TableARN:       fwflex.StringToFramework(ctx, destination.TableArn),
+			TableBucketARN: fwtypes.ARNValue(aws.ToString(destination.TableBucketArn)),
+			TableName:      fwflex.StringToFramework(ctx, destination.TableName),
+			TableNamespace: fwflex.StringToFramework(ctx, destination.TableNamespace),
+		}),
+	})
+	data.Status = fwflex.StringToFramework(ctx, apiObject.Status)
+
+	return diags
+}
+
+type metadataTableConfigurationModel struct {
+	S3TablesDestination fwtypes.ListNestedObjectValueOf[s3TablesDestinationModel] `tfsdk:"s3tables_destination"`
+}
+
+type s3TablesDestinationModel struct {
+	TableARN       types.String `tfsdk:"table_arn"`
+	TableBucketARN fwtypes.ARN  `tfsdk:"table_bucket_arn"`
+	TableName      types.String `tfsdk:"table_name"`
+	TableNamespace types.String `tfsdk:"table_namespace"`
+}
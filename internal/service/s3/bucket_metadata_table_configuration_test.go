@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetadataTableConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableBucketARN := acctest.SkipIfEnvVarNotSet(t, "S3_METADATA_TABLE_BUCKET_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	tableName := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName, tableBucketARN, tableName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3tables_destination.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_table_configuration.0.s3tables_destination.0.table_arn"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3tables_destination.0.table_bucket_arn", tableBucketARN),
+					resource.TestCheckResourceAttr(resourceName, "metadata_table_configuration.0.s3tables_destination.0.table_name", tableName),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_table_configuration.0.s3tables_destination.0.table_namespace"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetadataTableConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableBucketARN := acctest.SkipIfEnvVarNotSet(t, "S3_METADATA_TABLE_BUCKET_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	tableName := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3_bucket_metadata_table_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataTableConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataTableConfigurationConfig_basic(rName, tableBucketARN, tableName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataTableConfigurationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketMetadataTableConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketMetadataTableConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_metadata_table_configuration" {
+				continue
+			}
+
+			bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3.FindBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Metadata Table Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketMetadataTableConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err = tfs3.FindBucketMetadataTableConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		return err
+	}
+}
+
+func testAccBucketMetadataTableConfigurationConfig_basic(rName, tableBucketARN, tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_metadata_table_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  metadata_table_configuration {
+    s3tables_destination {
+      table_bucket_arn = %[2]q
+      table_name       = %[3]q
+    }
+  }
+}
+`, rName, tableBucketARN, tableName)
+}
@@ -45,6 +45,9 @@ func (d *directoryBucketsDataSource) Schema(ctx context.Context, req datasource.
 				Computed:    true,
 			},
 			names.AttrID: framework.IDAttribute(),
+			"location_name": schema.StringAttribute{
+				Optional: true,
+			},
 		},
 	}
 }
@@ -73,7 +76,16 @@ func (d *directoryBucketsDataSource) Read(ctx context.Context, request datasourc
 		}
 
 		for _, v := range page.Buckets {
-			buckets = append(buckets, aws.ToString(v.Name))
+			bucket := aws.ToString(v.Name)
+
+			if locationName := data.LocationName.ValueString(); locationName != "" {
+				// The bucket's Availability Zone ID is part of its name.
+				if m := directoryBucketNameRegex.FindStringSubmatch(bucket); len(m) != 3 || m[2] != locationName {
+					continue
+				}
+			}
+
+			buckets = append(buckets, bucket)
 		}
 	}
 
@@ -87,7 +99,8 @@ func (d *directoryBucketsDataSource) Read(ctx context.Context, request datasourc
 }
 
 type directoryBucketsDataSourceModel struct {
-	ARNs    types.List   `tfsdk:"arns"`
-	Buckets types.List   `tfsdk:"buckets"`
-	ID      types.String `tfsdk:"id"`
+	ARNs         types.List   `tfsdk:"arns"`
+	Buckets      types.List   `tfsdk:"buckets"`
+	ID           types.String `tfsdk:"id"`
+	LocationName types.String `tfsdk:"location_name"`
 }
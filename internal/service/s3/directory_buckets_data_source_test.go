@@ -34,6 +34,29 @@ func TestAccS3DirectoryBucketsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3DirectoryBucketsDataSource_locationName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_directory_buckets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryBucketsDataSourceConfig_locationName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "arns.#", 1),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "buckets.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "buckets.*", "aws_s3_directory_bucket.test", names.AttrBucket),
+				),
+			},
+		},
+	})
+}
+
 func testAccDirectoryBucketsDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), `
 resource "aws_s3_directory_bucket" "test" {
@@ -49,3 +72,21 @@ data "aws_s3_directory_buckets" "test" {
 }
 `)
 }
+
+func testAccDirectoryBucketsDataSourceConfig_locationName(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), `
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+
+data "aws_s3_directory_buckets" "test" {
+  location_name = local.location_name
+
+  depends_on = [aws_s3_directory_bucket.test]
+}
+`)
+}
@@ -8,5 +8,6 @@ var (
 	ResourceBucket = resourceBucket
 	ResourceObject = resourceObject
 
-	BucketListTags = bucketListTags
+	BucketListTags           = bucketListTags
+	FindObjectByBucketAndKey = findObjectByBucketAndKey
 )
@@ -41,6 +41,7 @@ import (
 
 // @SDKResource("aws_s3_object", name="Object")
 // @Tags(identifierAttribute="arn", resourceType="Object")
+// @OverrideProvider
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3;s3.GetObjectOutput")
 // @Testing(importStateIdFunc=testAccObjectImportStateIdFunc)
 // @Testing(importIgnore="force_destroy")
@@ -171,6 +172,16 @@ func resourceObject() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				ValidateFunc: validateMetadataIsLowerCase,
 			},
+			"multipart_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -579,12 +590,34 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if v, ok := d.GetOk("multipart_chunk_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+		if v, ok := d.GetOk("multipart_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
+
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "determining S3 Object (%s) size: %s", aws.ToString(input.Key), err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return sdkdiag.AppendErrorf(diags, "determining S3 Object (%s) size: %s", aws.ToString(input.Key), err)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object (%s) to Bucket (%s): %d bytes, part size %d bytes, concurrency %d", aws.ToString(input.Key), aws.ToString(input.Bucket), size, uploader.PartSize, uploader.Concurrency)
+	start := time.Now()
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
 	}
 
+	log.Printf("[DEBUG] Uploaded S3 Object (%s) to Bucket (%s) in %d part(s) in %s", aws.ToString(input.Key), aws.ToString(input.Bucket), max(len(output.CompletedParts), 1), time.Since(start))
+
 	if d.IsNewResource() {
 		d.SetId(d.Get(names.AttrKey).(string))
 	}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -284,6 +285,53 @@ func TestAccS3Object_source(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipart(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// Large enough to be uploaded in 3 parts of the minimum part size.
+	content := strings.Repeat("0123456789abcdef", 12*1024*1024/16)
+	source := testAccObjectCreateTempFile(t, content)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipart(rName, source, 5242880, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, content),
+					resource.TestCheckResourceAttr(resourceName, "multipart_chunk_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "multipart_chunk_size", "multipart_concurrency", names.AttrSource},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+			{
+				// Changing the upload settings doesn't re-upload the object.
+				Config: testAccObjectConfig_multipart(rName, source, 10485760, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, content),
+					resource.TestCheckResourceAttr(resourceName, "multipart_chunk_size", "10485760"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "4"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_content(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2396,6 +2444,24 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_multipart(rName, source string, chunkSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "test-key"
+  source       = %[2]q
+  content_type = "binary/octet-stream"
+
+  multipart_chunk_size  = %[3]d
+  multipart_concurrency = %[4]d
+}
+`, rName, source, chunkSize, concurrency)
+}
+
 func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
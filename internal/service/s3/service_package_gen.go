@@ -23,6 +23,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newBucketMetadataTableConfigurationResource,
+			Name:    "Bucket Metadata Table Configuration",
+		},
 		{
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
@@ -201,6 +205,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Object",
 			},
+			OverrideProvider: true,
 		},
 		{
 			Factory:  resourceObjectCopy,
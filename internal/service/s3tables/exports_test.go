@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+// Exports for use in tests only.
+var (
+	ResourceNamespace   = newNamespaceResource
+	ResourceTable       = newTableResource
+	ResourceTableBucket = newTableBucketResource
+
+	FindNamespaceByTwoPartKey                       = findNamespaceByTwoPartKey
+	FindTableBucketByARN                            = findTableBucketByARN
+	FindTableBucketMaintenanceConfigurationByARN    = findTableBucketMaintenanceConfigurationByARN
+	FindTableByThreePartKey                         = findTableByThreePartKey
+	FindTableMaintenanceConfigurationByThreePartKey = findTableMaintenanceConfigurationByThreePartKey
+)
@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_table", name="Table")
+func newTableResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableResource{}
+
+	return r, nil
+}
+
+type tableResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*tableResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table"
+}
+
+func (r *tableResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OpenTableFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"metadata_location": schema.StringAttribute{
+				Computed: true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"modified_by": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: nameValidators(),
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: nameValidators(),
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TableType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_token": schema.StringAttribute{
+				Computed: true,
+			},
+			"warehouse_location": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableMaintenanceConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"iceberg_compaction": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[icebergCompactionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrStatus: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+										Required:   true,
+									},
+									"target_file_size_mb": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int32{
+											int32validator.Between(64, 512),
+										},
+									},
+								},
+							},
+						},
+						"iceberg_snapshot_management": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[icebergSnapshotManagementModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"max_snapshot_age_hours": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									"min_snapshots_to_keep": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									names.AttrStatus: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *tableResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &s3tables.CreateTableInput{
+		Format:         data.Format.ValueEnum(),
+		Name:           aws.String(name),
+		Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+	}
+
+	_, err := conn.CreateTable(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table (%s)", name), err.Error())
+
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID S3 Tables Table", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	if !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(data.putMaintenanceConfiguration(ctx, conn, nil)...)
+		if response.Diagnostics.HasError() {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			return
+		}
+	}
+
+	table, err := findTableByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.flatten(ctx, table)
+
+	response.Diagnostics.Append(data.refreshMaintenanceConfiguration(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	table, err := findTableByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, table)
+
+	response.Diagnostics.Append(data.refreshMaintenanceConfiguration(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	if !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) && !new.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(new.putMaintenanceConfiguration(ctx, conn, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	table, err := findTableByThreePartKey(ctx, conn, new.TableBucketARN.ValueString(), new.Namespace.ValueString(), new.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.flatten(ctx, table)
+
+	response.Diagnostics.Append(new.refreshMaintenanceConfiguration(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	_, err := conn.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		Name:           fwflex.StringFromFramework(ctx, data.Name),
+		Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTableByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (*s3tables.GetTableOutput, error) {
+	input := &s3tables.GetTableInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTable(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableMaintenanceConfigurationByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (*s3tables.GetTableMaintenanceConfigurationOutput, error) {
+	input := &s3tables.GetTableMaintenanceConfigurationInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTableMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type tableResourceModel struct {
+	ARN                      types.String                                                        `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	CreatedBy                types.String                                                        `tfsdk:"created_by"`
+	Format                   fwtypes.StringEnum[awstypes.OpenTableFormat]                        `tfsdk:"format"`
+	ID                       types.String                                                        `tfsdk:"id"`
+	MaintenanceConfiguration fwtypes.ListNestedObjectValueOf[tableMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	MetadataLocation         types.String                                                        `tfsdk:"metadata_location"`
+	ModifiedAt               timetypes.RFC3339                                                   `tfsdk:"modified_at"`
+	ModifiedBy               types.String                                                        `tfsdk:"modified_by"`
+	Name                     types.String                                                        `tfsdk:"name"`
+	Namespace                types.String                                                        `tfsdk:"namespace"`
+	OwnerAccountID           types.String                                                        `tfsdk:"owner_account_id"`
+	TableBucketARN           fwtypes.ARN                                                         `tfsdk:"table_bucket_arn"`
+	Type                     fwtypes.StringEnum[awstypes.TableType]                              `tfsdk:"type"`
+	VersionToken             types.String                                                        `tfsdk:"version_token"`
+	WarehouseLocation        types.String                                                        `tfsdk:"warehouse_location"`
+}
+
+const (
+	tableResourceIDPartCount = 3
+)
+
+func (data *tableResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), tableResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.TableBucketARN = fwtypes.ARNValue(parts[0])
+	data.Namespace = types.StringValue(parts[1])
+	data.Name = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *tableResourceModel) setID() (string, error) {
+	parts := []string{
+		data.TableBucketARN.ValueString(),
+		data.Namespace.ValueString(),
+		data.Name.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, tableResourceIDPartCount, false)
+}
+
+func (data *tableResourceModel) flatten(ctx context.Context, output *s3tables.GetTableOutput) {
+	data.ARN = fwflex.StringToFramework(ctx, output.TableARN)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.CreatedBy = fwflex.StringToFramework(ctx, output.CreatedBy)
+	data.Format = fwtypes.StringEnumValue(output.Format)
+	data.MetadataLocation = fwflex.StringToFramework(ctx, output.MetadataLocation)
+	data.ModifiedAt = timetypes.NewRFC3339TimePointerValue(output.ModifiedAt)
+	data.ModifiedBy = fwflex.StringToFramework(ctx, output.ModifiedBy)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.OwnerAccountID = fwflex.StringToFramework(ctx, output.OwnerAccountId)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+	data.VersionToken = fwflex.StringToFramework(ctx, output.VersionToken)
+	data.WarehouseLocation = fwflex.StringToFramework(ctx, output.WarehouseLocation)
+}
+
+// refreshMaintenanceConfiguration reads back only the maintenance types that are configured.
+// The service applies a default maintenance configuration, which is otherwise left unmanaged.
+func (data *tableResourceModel) refreshMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.MaintenanceConfiguration.IsNull() {
+		return diags
+	}
+
+	configurationData, d := data.MaintenanceConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	maintenance, err := findTableMaintenanceConfigurationByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Tables Table (%s) maintenance configuration", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	if !configurationData.IcebergCompaction.IsNull() {
+		configurationData.IcebergCompaction = fwtypes.NewListNestedObjectValueOfNull[icebergCompactionModel](ctx)
+
+		if v, ok := maintenance.Configuration[string(awstypes.TableMaintenanceTypeIcebergCompaction)]; ok {
+			compactionData := &icebergCompactionModel{
+				Status:           fwtypes.StringEnumValue(v.Status),
+				TargetFileSizeMB: types.Int32Null(),
+			}
+
+			if v, ok := v.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergCompaction); ok {
+				compactionData.TargetFileSizeMB = types.Int32PointerValue(v.Value.TargetFileSizeMB)
+			}
+
+			configurationData.IcebergCompaction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, compactionData)
+		}
+	}
+
+	if !configurationData.IcebergSnapshotManagement.IsNull() {
+		configurationData.IcebergSnapshotManagement = fwtypes.NewListNestedObjectValueOfNull[icebergSnapshotManagementModel](ctx)
+
+		if v, ok := maintenance.Configuration[string(awstypes.TableMaintenanceTypeIcebergSnapshotManagement)]; ok {
+			snapshotManagementData := &icebergSnapshotManagementModel{
+				MaxSnapshotAgeHours: types.Int32Null(),
+				MinSnapshotsToKeep:  types.Int32Null(),
+				Status:              fwtypes.StringEnumValue(v.Status),
+			}
+
+			if v, ok := v.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement); ok {
+				snapshotManagementData.MaxSnapshotAgeHours = types.Int32PointerValue(v.Value.MaxSnapshotAgeHours)
+				snapshotManagementData.MinSnapshotsToKeep = types.Int32PointerValue(v.Value.MinSnapshotsToKeep)
+			}
+
+			configurationData.IcebergSnapshotManagement = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, snapshotManagementData)
+		}
+	}
+
+	data.MaintenanceConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configurationData)
+
+	return diags
+}
+
+// putMaintenanceConfiguration puts each configured maintenance type that differs from the old configuration, if any.
+func (data *tableResourceModel) putMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, old *tableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configurationData, d := data.MaintenanceConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var oldConfigurationData *tableMaintenanceConfigurationModel
+	if old != nil && !old.MaintenanceConfiguration.IsNull() {
+		oldConfigurationData, d = old.MaintenanceConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !configurationData.IcebergCompaction.IsNull() && (oldConfigurationData == nil || !configurationData.IcebergCompaction.Equal(oldConfigurationData.IcebergCompaction)) {
+		compactionData, d := configurationData.IcebergCompaction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var settings awstypes.IcebergCompactionSettings
+		diags.Append(fwflex.Expand(ctx, compactionData, &settings)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(data.putMaintenanceConfigurationValue(ctx, conn, awstypes.TableMaintenanceTypeIcebergCompaction, &awstypes.TableMaintenanceConfigurationValue{
+			Settings: &awstypes.TableMaintenanceSettingsMemberIcebergCompaction{
+				Value: settings,
+			},
+			Status: compactionData.Status.ValueEnum(),
+		})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !configurationData.IcebergSnapshotManagement.IsNull() && (oldConfigurationData == nil || !configurationData.IcebergSnapshotManagement.Equal(oldConfigurationData.IcebergSnapshotManagement)) {
+		snapshotManagementData, d := configurationData.IcebergSnapshotManagement.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var settings awstypes.IcebergSnapshotManagementSettings
+		diags.Append(fwflex.Expand(ctx, snapshotManagementData, &settings)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(data.putMaintenanceConfigurationValue(ctx, conn, awstypes.TableMaintenanceTypeIcebergSnapshotManagement, &awstypes.TableMaintenanceConfigurationValue{
+			Settings: &awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement{
+				Value: settings,
+			},
+			Status: snapshotManagementData.Status.ValueEnum(),
+		})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (data *tableResourceModel) putMaintenanceConfigurationValue(ctx context.Context, conn *s3tables.Client, maintenanceType awstypes.TableMaintenanceType, value *awstypes.TableMaintenanceConfigurationValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &s3tables.PutTableMaintenanceConfigurationInput{
+		Name:           fwflex.StringFromFramework(ctx, data.Name),
+		Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+		Type:           maintenanceType,
+		Value:          value,
+	}
+
+	_, err := conn.PutTableMaintenanceConfiguration(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting S3 Tables Table (%s) maintenance configuration (%s)", data.ID.ValueString(), maintenanceType), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+type tableMaintenanceConfigurationModel struct {
+	IcebergCompaction         fwtypes.ListNestedObjectValueOf[icebergCompactionModel]         `tfsdk:"iceberg_compaction"`
+	IcebergSnapshotManagement fwtypes.ListNestedObjectValueOf[icebergSnapshotManagementModel] `tfsdk:"iceberg_snapshot_management"`
+}
+
+type icebergCompactionModel struct {
+	Status           fwtypes.StringEnum[awstypes.MaintenanceStatus] `tfsdk:"status"`
+	TargetFileSizeMB types.Int32                                    `tfsdk:"target_file_size_mb"`
+}
+
+type icebergSnapshotManagementModel struct {
+	MaxSnapshotAgeHours types.Int32                                    `tfsdk:"max_snapshot_age_hours"`
+	MinSnapshotsToKeep  types.Int32                                    `tfsdk:"min_snapshots_to_keep"`
+	Status              fwtypes.StringEnum[awstypes.MaintenanceStatus] `tfsdk:"status"`
+}
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_table_bucket", name="Table Bucket")
+func newTableBucketResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableBucketResource{}
+
+	return r, nil
+}
+
+type tableBucketResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*tableBucketResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table_bucket"
+}
+
+func (r *tableBucketResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z-]*[0-9a-z]$`), "must contain only lowercase letters, numbers, or hyphens, and must begin and end with a letter or number"),
+				},
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableBucketMaintenanceConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"iceberg_unreferenced_file_removal": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[icebergUnreferencedFileRemovalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"non_current_days": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									names.AttrStatus: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+										Required:   true,
+									},
+									"unreferenced_days": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *tableBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &s3tables.CreateTableBucketInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.CreateTableBucket(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table Bucket (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Arn)
+	data.ID = types.StringValue(arn)
+
+	if !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, arn, data.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			return
+		}
+	}
+
+	tableBucket, err := findTableBucketByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.flatten(ctx, tableBucket)
+
+	if !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(data.refreshMaintenanceConfiguration(ctx, conn)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableBucketResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	tableBucket, err := findTableBucketByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, tableBucket)
+
+	// The service applies a default maintenance configuration, which is only tracked when configured.
+	if !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(data.refreshMaintenanceConfiguration(ctx, conn)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableBucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	if !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) && !new.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, new.ID.ValueString(), new.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshMaintenanceConfiguration(ctx, conn)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableBucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	_, err := conn.DeleteTableBucket(ctx, &s3tables.DeleteTableBucketInput{
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putTableBucketMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, arn string, v fwtypes.ListNestedObjectValueOf[tableBucketMaintenanceConfigurationModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configurationData, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	unreferencedFileRemovalData, d := configurationData.IcebergUnreferencedFileRemoval.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var settings awstypes.IcebergUnreferencedFileRemovalSettings
+	diags.Append(fwflex.Expand(ctx, unreferencedFileRemovalData, &settings)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := &s3tables.PutTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+		Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+		Value: &awstypes.TableBucketMaintenanceConfigurationValue{
+			Settings: &awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval{
+				Value: settings,
+			},
+			Status: unreferencedFileRemovalData.Status.ValueEnum(),
+		},
+	}
+
+	_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting S3 Tables Table Bucket (%s) maintenance configuration", arn), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func findTableBucketByARN(ctx context.Context, conn *s3tables.Client, arn string) (*s3tables.GetTableBucketOutput, error) {
+	input := &s3tables.GetTableBucketInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucket(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableBucketMaintenanceConfigurationByARN(ctx context.Context, conn *s3tables.Client, arn string) (*s3tables.GetTableBucketMaintenanceConfigurationOutput, error) {
+	input := &s3tables.GetTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucketMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type tableBucketResourceModel struct {
+	ARN                      types.String                                                              `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                                         `tfsdk:"created_at"`
+	ID                       types.String                                                              `tfsdk:"id"`
+	MaintenanceConfiguration fwtypes.ListNestedObjectValueOf[tableBucketMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	Name                     types.String                                                              `tfsdk:"name"`
+	OwnerAccountID           types.String                                                              `tfsdk:"owner_account_id"`
+}
+
+func (data *tableBucketResourceModel) flatten(ctx context.Context, apiObject *s3tables.GetTableBucketOutput) {
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.Arn)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(apiObject.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, apiObject.Arn)
+	data.Name = fwflex.StringToFramework(ctx, apiObject.Name)
+	data.OwnerAccountID = fwflex.StringToFramework(ctx, apiObject.OwnerAccountId)
+}
+
+func (data *tableBucketResourceModel) refreshMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := findTableBucketMaintenanceConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s) maintenance configuration", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	configurationData := &tableBucketMaintenanceConfigurationModel{
+		IcebergUnreferencedFileRemoval: fwtypes.NewListNestedObjectValueOfNull[icebergUnreferencedFileRemovalModel](ctx),
+	}
+
+	if v, ok := output.Configuration[string(awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval)]; ok {
+		unreferencedFileRemovalData := &icebergUnreferencedFileRemovalModel{
+			Status: fwtypes.StringEnumValue(v.Status),
+		}
+
+		if v, ok := v.Settings.(*awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval); ok {
+			unreferencedFileRemovalData.NonCurrentDays = types.Int32PointerValue(v.Value.NonCurrentDays)
+			unreferencedFileRemovalData.UnreferencedDays = types.Int32PointerValue(v.Value.UnreferencedDays)
+		} else {
+			unreferencedFileRemovalData.NonCurrentDays = types.Int32Null()
+			unreferencedFileRemovalData.UnreferencedDays = types.Int32Null()
+		}
+
+		configurationData.IcebergUnreferencedFileRemoval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unreferencedFileRemovalData)
+	}
+
+	data.MaintenanceConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configurationData)
+
+	return diags
+}
+
+type tableBucketMaintenanceConfigurationModel struct {
+	IcebergUnreferencedFileRemoval fwtypes.ListNestedObjectValueOf[icebergUnreferencedFileRemovalModel] `tfsdk:"iceberg_unreferenced_file_removal"`
+}
+
+type icebergUnreferencedFileRemovalModel struct {
+	NonCurrentDays   types.Int32                                    `tfsdk:"non_current_days"`
+	Status           fwtypes.StringEnum[awstypes.MaintenanceStatus] `tfsdk:"status"`
+	UnreferencedDays types.Int32                                    `tfsdk:"unreferenced_days"`
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3tables "github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "s3tables", regexache.MustCompile(`bucket/`+rName+`/table/.+$`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "ICEBERG"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, name),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, "aws_s3tables_namespace.test", names.AttrNamespace),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwnerAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "customer"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "warehouse_location"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3tables.ResourceTable, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, name, 64, 24, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_compaction.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_compaction.0.status", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_compaction.0.target_file_size_mb", "64"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.0.max_snapshot_age_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.0.min_snapshots_to_keep", "2"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.0.status", "enabled"),
+				),
+			},
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, name, 128, 48, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_compaction.0.target_file_size_mb", "128"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.0.max_snapshot_age_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.0.iceberg_snapshot_management.0.min_snapshots_to_keep", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3tables_table" {
+				continue
+			}
+
+			_, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Tables Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		_, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccTableConfig_base(rName, name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3tables_namespace" "test" {
+  namespace        = %[2]q
+  table_bucket_arn = aws_s3tables_table_bucket.test.arn
+}
+`, rName, name)
+}
+
+func testAccTableConfig_basic(rName, name string) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(rName, name), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+}
+`, name))
+}
+
+func testAccTableConfig_maintenanceConfiguration(rName, name string, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep int) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(rName, name), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  maintenance_configuration {
+    iceberg_compaction {
+      status              = "enabled"
+      target_file_size_mb = %[2]d
+    }
+
+    iceberg_snapshot_management {
+      status                 = "enabled"
+      max_snapshot_age_hours = %[3]d
+      min_snapshots_to_keep  = %[4]d
+    }
+  }
+}
+`, name, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep))
+}
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_sagemaker_endpoint", name="Endpoint")
 // @Tags(identifierAttribute="arn")
+// @Permissions(create="sagemaker:CreateEndpoint;sagemaker:DescribeEndpoint;sagemaker:AddTags", update="sagemaker:UpdateEndpoint;sagemaker:DescribeEndpoint;sagemaker:AddTags;sagemaker:DeleteTags", delete="sagemaker:DeleteEndpoint;sagemaker:DescribeEndpoint")
 func resourceEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointCreate,
@@ -39,6 +40,12 @@ func resourceEndpoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -267,7 +274,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(name)
 
-	if _, err := waitEndpointInService(ctx, conn, d.Id()); err != nil {
+	if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) create: %s", name, err)
 	}
 
@@ -320,9 +327,20 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Endpoint (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitEndpointInService(ctx, conn, d.Id()); err != nil {
+		output, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) update: %s", d.Id(), err)
 		}
+
+		// A blue/green or rolling deployment whose auto-rollback alarms fire returns the endpoint to service
+		// with the previous endpoint configuration.
+		if got, want := aws.ToString(output.EndpointConfigName), aws.ToString(input.EndpointConfigName); got != want {
+			// Record the endpoint configuration that is actually in service so that the next plan shows a diff.
+			d.Set("endpoint_config_name", got)
+
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Endpoint (%s): deployment of endpoint configuration (%s) was rolled back to (%s)", d.Id(), want, got)
+		}
 	}
 
 	return append(diags, resourceEndpointRead(ctx, d, meta)...)
@@ -345,7 +363,7 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Endpoint (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitEndpointDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Endpoint (%s) delete: %s", d.Id(), err)
 	}
 
@@ -410,12 +428,9 @@ func statusEndpoint(ctx context.Context, conn *sagemaker.Client, name string) re
 	}
 }
 
-func waitEndpointInService(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeEndpointOutput, error) { //nolint:unparam
-	const (
-		timeout = 60 * time.Minute
-	)
+func waitEndpointInService(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeEndpointOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.EndpointStatusCreating, awstypes.EndpointStatusUpdating, awstypes.EndpointStatusSystemUpdating),
+		Pending: enum.Slice(awstypes.EndpointStatusCreating, awstypes.EndpointStatusUpdating, awstypes.EndpointStatusSystemUpdating, awstypes.EndpointStatusRollingBack),
 		Target:  enum.Slice(awstypes.EndpointStatusInService),
 		Refresh: statusEndpoint(ctx, conn, name),
 		Timeout: timeout,
@@ -434,10 +449,7 @@ func waitEndpointInService(ctx context.Context, conn *sagemaker.Client, name str
 	return nil, err
 }
 
-func waitEndpointDeleted(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeEndpointOutput, error) {
-	const (
-		timeout = 10 * time.Minute
-	)
+func waitEndpointDeleted(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeEndpointOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.EndpointStatusDeleting),
 		Target:  []string{},
@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_inference_component", name="Inference Component")
+// @Tags(identifierAttribute="arn")
+func resourceInferenceComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInferenceComponentCreate,
+		ReadWithoutTimeout:   resourceInferenceComponentRead,
+		UpdateWithoutTimeout: resourceInferenceComponentUpdate,
+		DeleteWithoutTimeout: resourceInferenceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"runtime_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"current_copy_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_resource_requirements": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"min_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"number_of_accelerator_devices_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"number_of_cpu_cores_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0.25),
+									},
+								},
+							},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"artifact_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validModelDataURL,
+									},
+									names.AttrEnvironment: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validImage,
+									},
+								},
+							},
+						},
+						"model_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validName,
+						},
+						"startup_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_startup_health_check_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+									"model_data_download_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"variant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInferenceComponentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &sagemaker.CreateInferenceComponentInput{
+		EndpointName:           aws.String(d.Get("endpoint_name").(string)),
+		InferenceComponentName: aws.String(name),
+		RuntimeConfig:          expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{})),
+		Specification:          expandInferenceComponentSpecification(d.Get("specification").([]interface{})),
+		Tags:                   getTagsIn(ctx),
+		VariantName:            aws.String(d.Get("variant_name").(string)),
+	}
+
+	_, err := conn.CreateInferenceComponent(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Inference Component (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitInferenceComponentInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	output, err := findInferenceComponentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Inference Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.InferenceComponentArn)
+	d.Set("endpoint_name", output.EndpointName)
+	d.Set(names.AttrName, output.InferenceComponentName)
+	if err := d.Set("runtime_config", flattenInferenceComponentRuntimeConfigSummary(output.RuntimeConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_config: %s", err)
+	}
+	if err := d.Set("specification", flattenInferenceComponentSpecificationSummary(output.Specification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting specification: %s", err)
+	}
+	d.Set("variant_name", output.VariantName)
+
+	return diags
+}
+
+func resourceInferenceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &sagemaker.UpdateInferenceComponentInput{
+			InferenceComponentName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("runtime_config") {
+			input.RuntimeConfig = expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{}))
+		}
+
+		if d.HasChange("specification") {
+			input.Specification = expandInferenceComponentSpecification(d.Get("specification").([]interface{}))
+		}
+
+		_, err := conn.UpdateInferenceComponent(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Inference Component (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInferenceComponentInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker Inference Component: %s", d.Id())
+	_, err := conn.DeleteInferenceComponent(ctx, &sagemaker.DeleteInferenceComponentInput{
+		InferenceComponentName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInferenceComponentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findInferenceComponentByName(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	input := &sagemaker.DescribeInferenceComponentInput{
+		InferenceComponentName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceComponent(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.InferenceComponentStatus; status == awstypes.InferenceComponentStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusInferenceComponent(ctx context.Context, conn *sagemaker.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInferenceComponentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.InferenceComponentStatus), nil
+	}
+}
+
+func waitInferenceComponentInService(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InferenceComponentStatusCreating, awstypes.InferenceComponentStatusUpdating),
+		Target:  enum.Slice(awstypes.InferenceComponentStatusInService),
+		Refresh: statusInferenceComponent(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if failureReason := output.FailureReason; failureReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(failureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInferenceComponentDeleted(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InferenceComponentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusInferenceComponent(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if failureReason := output.FailureReason; failureReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(failureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandInferenceComponentRuntimeConfig(tfList []interface{}) *awstypes.InferenceComponentRuntimeConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.InferenceComponentRuntimeConfig{}
+
+	if v, ok := tfMap["copy_count"].(int); ok {
+		apiObject.CopyCount = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentSpecification(tfList []interface{}) *awstypes.InferenceComponentSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.InferenceComponentSpecification{}
+
+	if v, ok := tfMap["compute_resource_requirements"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ComputeResourceRequirements = expandInferenceComponentComputeResourceRequirements(v)
+	}
+
+	if v, ok := tfMap["container"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Container = expandInferenceComponentContainerSpecification(v)
+	}
+
+	if v, ok := tfMap["model_name"].(string); ok && v != "" {
+		apiObject.ModelName = aws.String(v)
+	}
+
+	if v, ok := tfMap["startup_parameters"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StartupParameters = expandInferenceComponentStartupParameters(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentComputeResourceRequirements(tfList []interface{}) *awstypes.InferenceComponentComputeResourceRequirements {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.InferenceComponentComputeResourceRequirements{}
+
+	if v, ok := tfMap["max_memory_required_in_mb"].(int); ok && v != 0 {
+		apiObject.MaxMemoryRequiredInMb = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["min_memory_required_in_mb"].(int); ok && v != 0 {
+		apiObject.MinMemoryRequiredInMb = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["number_of_accelerator_devices_required"].(float64); ok && v != 0 {
+		apiObject.NumberOfAcceleratorDevicesRequired = aws.Float32(float32(v))
+	}
+
+	if v, ok := tfMap["number_of_cpu_cores_required"].(float64); ok && v != 0 {
+		apiObject.NumberOfCpuCoresRequired = aws.Float32(float32(v))
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentContainerSpecification(tfList []interface{}) *awstypes.InferenceComponentContainerSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.InferenceComponentContainerSpecification{}
+
+	if v, ok := tfMap["artifact_url"].(string); ok && v != "" {
+		apiObject.ArtifactUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEnvironment].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Environment = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["image"].(string); ok && v != "" {
+		apiObject.Image = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentStartupParameters(tfList []interface{}) *awstypes.InferenceComponentStartupParameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.InferenceComponentStartupParameters{}
+
+	if v, ok := tfMap["container_startup_health_check_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.ContainerStartupHealthCheckTimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["model_data_download_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.ModelDataDownloadTimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenInferenceComponentRuntimeConfigSummary(apiObject *awstypes.InferenceComponentRuntimeConfigSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"copy_count":         aws.ToInt32(apiObject.DesiredCopyCount),
+		"current_copy_count": aws.ToInt32(apiObject.CurrentCopyCount),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentSpecificationSummary(apiObject *awstypes.InferenceComponentSpecificationSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"model_name": aws.ToString(apiObject.ModelName),
+	}
+
+	if v := apiObject.ComputeResourceRequirements; v != nil {
+		tfMap["compute_resource_requirements"] = flattenInferenceComponentComputeResourceRequirements(v)
+	}
+
+	if v := apiObject.Container; v != nil {
+		tfMap["container"] = flattenInferenceComponentContainerSpecificationSummary(v)
+	}
+
+	if v := apiObject.StartupParameters; v != nil {
+		tfMap["startup_parameters"] = flattenInferenceComponentStartupParameters(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentComputeResourceRequirements(apiObject *awstypes.InferenceComponentComputeResourceRequirements) []interface{} {
+	tfMap := map[string]interface{}{
+		"max_memory_required_in_mb": aws.ToInt32(apiObject.MaxMemoryRequiredInMb),
+		"min_memory_required_in_mb": aws.ToInt32(apiObject.MinMemoryRequiredInMb),
+	}
+
+	if v := apiObject.NumberOfAcceleratorDevicesRequired; v != nil {
+		tfMap["number_of_accelerator_devices_required"] = float64(aws.ToFloat32(v))
+	}
+
+	if v := apiObject.NumberOfCpuCoresRequired; v != nil {
+		tfMap["number_of_cpu_cores_required"] = float64(aws.ToFloat32(v))
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentContainerSpecificationSummary(apiObject *awstypes.InferenceComponentContainerSpecificationSummary) []interface{} {
+	tfMap := map[string]interface{}{
+		"artifact_url":        aws.ToString(apiObject.ArtifactUrl),
+		names.AttrEnvironment: flex.FlattenStringValueMap(apiObject.Environment),
+	}
+
+	if v := apiObject.DeployedImage; v != nil {
+		tfMap["image"] = aws.ToString(v.SpecifiedImage)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInferenceComponentStartupParameters(apiObject *awstypes.InferenceComponentStartupParameters) []interface{} {
+	tfMap := map[string]interface{}{
+		"container_startup_health_check_timeout_in_seconds": aws.ToInt32(apiObject.ContainerStartupHealthCheckTimeoutInSeconds),
+		"model_data_download_timeout_in_seconds":            aws.ToInt32(apiObject.ModelDataDownloadTimeoutInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
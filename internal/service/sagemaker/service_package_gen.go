@@ -94,6 +94,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Permissions: &types.ServicePackageResourcePermissions{
+				Create: []string{"sagemaker:CreateEndpoint", "sagemaker:DescribeEndpoint", "sagemaker:AddTags"},
+				Update: []string{"sagemaker:UpdateEndpoint", "sagemaker:DescribeEndpoint", "sagemaker:AddTags", "sagemaker:DeleteTags"},
+				Delete: []string{"sagemaker:DeleteEndpoint", "sagemaker:DescribeEndpoint"},
+			},
 		},
 		{
 			Factory:  resourceEndpointConfiguration,
@@ -148,6 +153,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sagemaker_image_version",
 			Name:     "Image Version",
 		},
+		{
+			Factory:  resourceInferenceComponent,
+			TypeName: "aws_sagemaker_inference_component",
+			Name:     "Inference Component",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceMlflowTrackingServer,
 			TypeName: "aws_sagemaker_mlflow_tracking_server",
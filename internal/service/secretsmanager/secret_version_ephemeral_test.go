@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretVersionEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_region.secret"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralConfig_base(rName, acctest.AlternateRegion()),
+			},
+			{
+				Config: testAccSecretVersionEphemeralConfig_basic(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretVersionEphemeralConfig_base(rName, secretString string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = %[2]q
+}
+`, rName, secretString)
+}
+
+// The secret value is used to configure a provider, as ephemeral values can't be stored in state.
+func testAccSecretVersionEphemeralConfig_basic(rName, secretString string) string {
+	return acctest.ConfigCompose(testAccSecretVersionEphemeralConfig_base(rName, secretString), `
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id  = aws_secretsmanager_secret.test.id
+  version_id = aws_secretsmanager_secret_version.test.version_id
+}
+
+provider "aws" {
+  alias  = "secret"
+  region = ephemeral.aws_secretsmanager_secret_version.test.secret_string
+}
+
+data "aws_region" "secret" {
+  provider = aws.secret
+}
+`)
+}
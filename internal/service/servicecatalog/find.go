@@ -208,3 +208,48 @@ func findProductByID(ctx context.Context, conn *servicecatalog.Client, productID
 
 	return out, nil
 }
+
+func findProvisionedProductPlanByID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) (*servicecatalog.DescribeProvisionedProductPlanOutput, error) {
+	input := &servicecatalog.DescribeProvisionedProductPlanInput{
+		PlanId: aws.String(planID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var output *servicecatalog.DescribeProvisionedProductPlanOutput
+
+	for {
+		page, err := conn.DescribeProvisionedProductPlan(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || page.ProvisionedProductPlanDetails == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.ResourceChanges = append(output.ResourceChanges, page.ResourceChanges...)
+		}
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
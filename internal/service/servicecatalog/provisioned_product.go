@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,7 +33,7 @@ import (
 
 // @SDKResource("aws_servicecatalog_provisioned_product", name="Provisioned Product")
 // @Tags
-// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;awstypes;awstypes.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;provisioning_artifact_name;provisioning_parameters;retain_physical_resources", skipEmptyTags=true, noRemoveTags=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;awstypes;awstypes.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;parameters;plan_preview;planned_resource_changes;provisioning_artifact_name;provisioning_parameters;retain_physical_resources", skipEmptyTags=true, noRemoveTags=true)
 // @Testing(tagsIdentifierAttribute="id", tagsResourceType="Provisioned Product")
 // @Testing(tagsUpdateGetTagsIn=true)
 func resourceProvisionedProduct() *schema.Resource {
@@ -124,6 +126,12 @@ func resourceProvisionedProduct() *schema.Resource {
 					},
 				},
 			},
+			names.AttrParameters: {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"provisioning_parameters"},
+			},
 			"path_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -139,6 +147,39 @@ func resourceProvisionedProduct() *schema.Resource {
 					"path_id",
 				},
 			},
+			"plan_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"planned_resource_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"product_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -174,8 +215,9 @@ func resourceProvisionedProduct() *schema.Resource {
 				},
 			},
 			"provisioning_parameters": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{names.AttrParameters},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrKey: {
@@ -267,13 +309,14 @@ func resourceProvisionedProduct() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			refreshOutputsDiff,
+			planResourceChangesDiff,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
 func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	if diff.HasChanges("provisioning_parameters", "provisioning_artifact_id", "provisioning_artifact_name") {
+	if diff.HasChanges(names.AttrParameters, "provisioning_parameters", "provisioning_artifact_id", "provisioning_artifact_name") {
 		if err := diff.SetNewComputed("outputs"); err != nil {
 			return err
 		}
@@ -282,6 +325,75 @@ func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// planResourceChangesDiff runs a Service Catalog provisioned product plan and stores the
+// resulting CloudFormation resource changes in the diff so they are shown in the Terraform plan.
+func planResourceChangesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("plan_preview").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges(names.AttrParameters, "provisioning_parameters", "provisioning_artifact_id", "provisioning_artifact_name") {
+		return nil
+	}
+
+	// A plan can only be created once the product and provisioning artifact IDs are known.
+	productID, provisioningArtifactID := diff.Get("product_id").(string), diff.Get("provisioning_artifact_id").(string)
+	if productID == "" || provisioningArtifactID == "" || diff.HasChange("provisioning_artifact_name") || !diff.NewValueKnown(names.AttrParameters) || !diff.NewValueKnown("provisioning_parameters") {
+		return diff.SetNewComputed("planned_resource_changes")
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage := diff.Get("accept_language").(string)
+	input := &servicecatalog.CreateProvisionedProductPlanInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		IdempotencyToken:       aws.String(id.UniqueId()),
+		PlanName:               aws.String(id.PrefixedUniqueId("terraform-")),
+		PlanType:               awstypes.ProvisionedProductPlanTypeCloudformation,
+		ProductId:              aws.String(productID),
+		ProvisionedProductName: aws.String(diff.Get(names.AttrName).(string)),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	if v, ok := diff.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := diff.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.ProvisioningParameters = expandUpdateProvisioningParametersMap(v.(map[string]interface{}))
+	} else if v, ok := diff.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	output, err := conn.CreateProvisionedProductPlan(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("creating Service Catalog Provisioned Product (%s) plan: %w", aws.ToString(input.ProvisionedProductName), err)
+	}
+
+	planID := aws.ToString(output.PlanId)
+
+	defer func() {
+		_, err := conn.DeleteProvisionedProductPlan(ctx, &servicecatalog.DeleteProvisionedProductPlanInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			IgnoreErrors:   true,
+			PlanId:         aws.String(planID),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] deleting Service Catalog Provisioned Product Plan (%s): %s", planID, err)
+		}
+	}()
+
+	plan, err := waitProvisionedProductPlanCreated(ctx, conn, acceptLanguage, planID, ProvisionedProductPlanReadyTimeout)
+
+	if err != nil {
+		return fmt.Errorf("waiting for Service Catalog Provisioned Product Plan (%s) create: %w", planID, err)
+	}
+
+	return diff.SetNew("planned_resource_changes", flattenResourceChanges(plan.ResourceChanges))
+}
+
 func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -324,7 +436,9 @@ func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceDat
 		input.ProvisioningArtifactName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.ProvisioningParameters = expandProvisioningParametersMap(v.(map[string]interface{}))
+	} else if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
 		input.ProvisioningParameters = expandProvisioningParameters(v.([]interface{}))
 	}
 
@@ -524,7 +638,9 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 		input.ProvisioningArtifactId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.ProvisioningParameters = expandUpdateProvisioningParametersMap(v.(map[string]interface{}))
+	} else if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
 		input.ProvisioningParameters = expandUpdateProvisioningParameters(v.([]interface{}))
 	}
 
@@ -643,6 +759,27 @@ func expandProvisioningParameters(tfList []interface{}) []awstypes.ProvisioningP
 	return apiObjects
 }
 
+func expandProvisioningParametersMap(tfMap map[string]interface{}) []awstypes.ProvisioningParameter {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.ProvisioningParameter
+
+	// Sort the parameters by key to make the request deterministic.
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		apiObjects = append(apiObjects, awstypes.ProvisioningParameter{
+			Key:   aws.String(k),
+			Value: aws.String(tfMap[k].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandProvisioningPreferences(tfMap map[string]interface{}) *awstypes.ProvisioningPreferences {
 	if tfMap == nil {
 		return nil
@@ -715,6 +852,27 @@ func expandUpdateProvisioningParameters(tfList []interface{}) []awstypes.UpdateP
 	return apiObjects
 }
 
+func expandUpdateProvisioningParametersMap(tfMap map[string]interface{}) []awstypes.UpdateProvisioningParameter {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.UpdateProvisioningParameter
+
+	// Sort the parameters by key to make the request deterministic.
+	keys := tfmaps.Keys(tfMap)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		apiObjects = append(apiObjects, awstypes.UpdateProvisioningParameter{
+			Key:   aws.String(k),
+			Value: aws.String(tfMap[k].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandUpdateProvisioningPreferences(tfMap map[string]interface{}) *awstypes.UpdateProvisioningPreferences {
 	if tfMap == nil {
 		return nil
@@ -790,3 +948,23 @@ func flattenRecordOutputs(apiObjects []awstypes.RecordOutput) []interface{} {
 
 	return tfList
 }
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:       apiObject.Action,
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          apiObject.Replacement,
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
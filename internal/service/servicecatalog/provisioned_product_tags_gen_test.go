@@ -76,7 +76,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -128,7 +128,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -175,7 +175,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -211,7 +211,7 @@ func TestAccServiceCatalogProvisionedProduct_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -267,7 +267,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_null(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -327,7 +327,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyMap(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -420,7 +420,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_AddOnUpdate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -483,7 +483,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnCreate(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -517,7 +517,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnCreate(t *testing.T
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -616,7 +616,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -662,7 +662,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Add(t *testi
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -756,7 +756,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_EmptyTag_OnUpdate_Replace(t *t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -817,7 +817,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -867,7 +867,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -914,7 +914,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -952,7 +952,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_providerOnly(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -1024,7 +1024,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1086,7 +1086,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1123,7 +1123,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nonOverlapping(t *
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 				SkipFunc: testAccServiceCatalogProvisionedProduct_removingTagNotSupported(t),
 			},
@@ -1193,7 +1193,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1255,7 +1255,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 			{
@@ -1309,7 +1309,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_overlapping(t *tes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1402,7 +1402,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_updateToProviderOn
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1494,7 +1494,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_updateToResourceOn
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1564,7 +1564,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_emptyResourceTag(t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1626,7 +1626,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_emptyProviderOnlyT
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1691,7 +1691,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nullOverlappingRes
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1756,7 +1756,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_DefaultTags_nullNonOverlapping
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1814,7 +1814,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnCreate(t *testin
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -1914,7 +1914,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnUpdate_Add(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -2004,7 +2004,7 @@ func TestAccServiceCatalogProvisionedProduct_tags_ComputedTag_OnUpdate_Replace(t
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language", "ignore_errors", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
+					"accept_language", "ignore_errors", names.AttrParameters, "plan_preview", "planned_resource_changes", "provisioning_artifact_name", "provisioning_parameters", "retain_physical_resources",
 				},
 			},
 		},
@@ -15,7 +15,10 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -71,6 +74,7 @@ func TestAccServiceCatalogProvisionedProduct_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"plan_preview",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -137,6 +141,7 @@ func TestAccServiceCatalogProvisionedProduct_update(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"plan_preview",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -146,6 +151,78 @@ func TestAccServiceCatalogProvisionedProduct_update(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_parameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_parameters(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VPCPrimaryCIDR", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "plan_preview", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.#", "0"),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_parameters(rName, "10.10.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VPCPrimaryCIDR", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisionedProduct_planPreview(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_planPreview(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "plan_preview", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_planPreview(rName, "10.10.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_resource_changes").AtSliceIndex(0).AtMapKey("logical_resource_id"), knownvalue.StringExact("MyVPC")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_resource_changes").AtSliceIndex(0).AtMapKey(names.AttrAction), knownvalue.StringExact(string(awstypes.ChangeActionModify))),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_resource_changes.0.resource_type", "AWS::EC2::VPC"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_stackSetProvisioningPreferences(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -177,6 +254,7 @@ func TestAccServiceCatalogProvisionedProduct_stackSetProvisioningPreferences(t *
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"plan_preview",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
@@ -246,6 +324,7 @@ func TestAccServiceCatalogProvisionedProduct_ProductName_update(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
 					"ignore_errors",
+					"plan_preview",
 					"product_name",
 					"provisioning_artifact_name",
 					"provisioning_parameters",
@@ -809,6 +888,45 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr))
 }
 
+func testAccProvisionedProductConfig_parameters(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+
+  parameters = {
+    VPCPrimaryCIDR = %[2]q
+    LeaveMeEmpty   = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
+func testAccProvisionedProductConfig_planPreview(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_servicecatalog_provisioning_artifacts" "test" {
+  product_id = aws_servicecatalog_product.test.id
+}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product.test.id
+  provisioning_artifact_id = data.aws_servicecatalog_provisioning_artifacts.test.provisioning_artifact_details[0].id
+  path_id                  = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  plan_preview             = true
+
+  parameters = {
+    VPCPrimaryCIDR = %[2]q
+    LeaveMeEmpty   = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
 func testAccProvisionedProductConfig_computedOutputs(rName, vpcCidr string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductPhysicalTemplateIDBaseConfig(rName),
 		fmt.Sprintf(`
@@ -388,3 +388,19 @@ func statusPortfolioConstraints(ctx context.Context, conn *servicecatalog.Client
 		return output, string(awstypes.StatusAvailable), nil
 	}
 }
+
+func statusProvisionedProductPlan(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProvisionedProductPlanByID(ctx, conn, acceptLanguage, planID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ProvisionedProductPlanDetails.Status), nil
+	}
+}
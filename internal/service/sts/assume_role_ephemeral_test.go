@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_caller_identity.assumed"
+	sessionName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_basic(os.Getenv(envvar.AccAssumeRoleARN), sessionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrARN, regexache.MustCompile(`:assumed-role/.+/`+sessionName+`$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_policy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_caller_identity.assumed"
+	sessionName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0"))),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.STSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_policy(os.Getenv(envvar.AccAssumeRoleARN), sessionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrARN, regexache.MustCompile(`:assumed-role/.+/`+sessionName+`$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleEphemeralConfig_base() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  alias = "assumed"
+
+  access_key = ephemeral.aws_sts_assume_role.test.access_key_id
+  secret_key = ephemeral.aws_sts_assume_role.test.secret_access_key
+  token      = ephemeral.aws_sts_assume_role.test.session_token
+}
+
+data "aws_caller_identity" "assumed" {
+  provider = aws.assumed
+}
+`
+}
+
+func testAccAssumeRoleEphemeralConfig_basic(roleARN, sessionName string) string {
+	return acctest.ConfigCompose(testAccAssumeRoleEphemeralConfig_base(), fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn          = %[1]q
+  role_session_name = %[2]q
+  duration          = "15m"
+}
+`, roleARN, sessionName))
+}
+
+func testAccAssumeRoleEphemeralConfig_policy(roleARN, sessionName string) string {
+	return acctest.ConfigCompose(testAccAssumeRoleEphemeralConfig_base(), fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn          = %[1]q
+  role_session_name = %[2]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "sts:GetCallerIdentity"
+      Resource = "*"
+    }]
+  })
+}
+`, roleARN, sessionName))
+}
@@ -111,6 +111,11 @@ func resourceConnector() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+$`), "must be in the format matching TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+"),
 				),
 			},
+			"service_managed_egress_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"sftp_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -208,6 +213,7 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("connector_id", output.ConnectorId)
 	d.Set("logging_role", output.LoggingRole)
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("service_managed_egress_ip_addresses", output.ServiceManagedEgressIpAddresses)
 	if err := d.Set("sftp_config", flattenSftpConnectorConfig(output.SftpConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sftp_config: %s", err)
 	}
@@ -334,8 +340,10 @@ func expandSftpConnectorConfig(tfList []interface{}) *awstypes.SftpConnectorConf
 
 	tfMap := tfList[0].(map[string]interface{})
 
-	apiObject := &awstypes.SftpConnectorConfig{
-		UserSecretId: aws.String(tfMap["user_secret_id"].(string)),
+	apiObject := &awstypes.SftpConnectorConfig{}
+
+	if v, ok := tfMap["user_secret_id"].(string); ok && v != "" {
+		apiObject.UserSecretId = aws.String(v)
 	}
 
 	if v, ok := tfMap["trusted_host_keys"].(*schema.Set); ok && len(v.List()) > 0 {
@@ -14,6 +14,7 @@ var (
 	ResourceSSHKey      = resourceSSHKey
 	ResourceTag         = resourceTag
 	ResourceUser        = resourceUser
+	ResourceWebApp      = newWebAppResource
 	ResourceWorkflow    = resourceWorkflow
 
 	FindAccessByTwoPartKey       = findAccessByTwoPartKey
@@ -25,5 +26,7 @@ var (
 	FindTag                      = findTag
 	FindUserByTwoPartKey         = findUserByTwoPartKey
 	FindUserSSHKeyByThreePartKey = findUserSSHKeyByThreePartKey
+	FindWebAppByID               = findWebAppByID
+	FindWebAppCustomizationByID  = findWebAppCustomizationByID
 	FindWorkflowByID             = findWorkflowByID
 )
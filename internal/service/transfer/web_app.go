@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_transfer_web_app", name="Web App")
+// @Tags(identifierAttribute="arn")
+func newWebAppResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &webAppResource{}
+
+	return r, nil
+}
+
+type webAppResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*webAppResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_transfer_web_app"
+}
+
+func (r *webAppResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_endpoint": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"web_app_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_app_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_app_units": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_details": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[webAppIdentityProviderDetailsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"identity_center_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[identityCenterConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"instance_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrRole: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *webAppResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data webAppResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	identityCenterConfig, diags := data.identityCenterConfig(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &transfer.CreateWebAppInput{
+		AccessEndpoint: fwflex.StringFromFramework(ctx, data.AccessEndpoint),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if identityCenterConfig != nil {
+		input.IdentityProviderDetails = &awstypes.WebAppIdentityProviderDetailsMemberIdentityCenterConfig{
+			Value: awstypes.IdentityCenterConfig{
+				InstanceArn: fwflex.StringFromFramework(ctx, identityCenterConfig.InstanceARN),
+				Role:        fwflex.StringFromFramework(ctx, identityCenterConfig.Role),
+			},
+		}
+	}
+
+	if !data.WebAppUnits.IsUnknown() && !data.WebAppUnits.IsNull() {
+		input.WebAppUnits = &awstypes.WebAppUnitsMemberProvisioned{
+			Value: fwflex.Int32ValueFromFramework(ctx, data.WebAppUnits),
+		}
+	}
+
+	output, err := conn.CreateWebApp(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Transfer Web App", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.WebAppId)
+	data.WebAppID = fwflex.StringValueToFramework(ctx, id)
+
+	webApp, err := findWebAppByID(ctx, conn, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("web_app_id"), data.WebAppID) // Set 'web_app_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Transfer Web App (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.flatten(ctx, webApp)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *webAppResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data webAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	output, err := findWebAppByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Transfer Web App (%s)", id), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, output)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *webAppResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new webAppResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := new.WebAppID.ValueString()
+
+	if !new.AccessEndpoint.Equal(old.AccessEndpoint) ||
+		!new.IdentityProviderDetails.Equal(old.IdentityProviderDetails) ||
+		!new.WebAppUnits.Equal(old.WebAppUnits) {
+		input := &transfer.UpdateWebAppInput{
+			WebAppId: aws.String(id),
+		}
+
+		if !new.AccessEndpoint.Equal(old.AccessEndpoint) {
+			input.AccessEndpoint = fwflex.StringFromFramework(ctx, new.AccessEndpoint)
+		}
+
+		if !new.IdentityProviderDetails.Equal(old.IdentityProviderDetails) {
+			identityCenterConfig, diags := new.identityCenterConfig(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if identityCenterConfig != nil {
+				input.IdentityProviderDetails = &awstypes.UpdateWebAppIdentityProviderDetailsMemberIdentityCenterConfig{
+					Value: awstypes.UpdateWebAppIdentityCenterConfig{
+						Role: fwflex.StringFromFramework(ctx, identityCenterConfig.Role),
+					},
+				}
+			}
+		}
+
+		if !new.WebAppUnits.Equal(old.WebAppUnits) && !new.WebAppUnits.IsUnknown() && !new.WebAppUnits.IsNull() {
+			input.WebAppUnits = &awstypes.WebAppUnitsMemberProvisioned{
+				Value: fwflex.Int32ValueFromFramework(ctx, new.WebAppUnits),
+			}
+		}
+
+		_, err := conn.UpdateWebApp(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Transfer Web App (%s)", id), err.Error())
+
+			return
+		}
+
+		output, err := findWebAppByID(ctx, conn, id)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Transfer Web App (%s)", id), err.Error())
+
+			return
+		}
+
+		new.flatten(ctx, output)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *webAppResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data webAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	_, err := conn.DeleteWebApp(ctx, &transfer.DeleteWebAppInput{
+		WebAppId: aws.String(id),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Transfer Web App (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func (r *webAppResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("web_app_id"), request, response)
+}
+
+func (r *webAppResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findWebAppByID(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedWebApp, error) {
+	input := &transfer.DescribeWebAppInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWebApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebApp == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebApp, nil
+}
+
+type webAppResourceModel struct {
+	AccessEndpoint          types.String                                                        `tfsdk:"access_endpoint"`
+	ARN                     types.String                                                        `tfsdk:"arn"`
+	IdentityProviderDetails fwtypes.ListNestedObjectValueOf[webAppIdentityProviderDetailsModel] `tfsdk:"identity_provider_details"`
+	Tags                    tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                          `tfsdk:"tags_all"`
+	WebAppEndpoint          types.String                                                        `tfsdk:"web_app_endpoint"`
+	WebAppID                types.String                                                        `tfsdk:"web_app_id"`
+	WebAppUnits             types.Int64                                                         `tfsdk:"web_app_units"`
+}
+
+type webAppIdentityProviderDetailsModel struct {
+	IdentityCenterConfig fwtypes.ListNestedObjectValueOf[identityCenterConfigModel] `tfsdk:"identity_center_config"`
+}
+
+type identityCenterConfigModel struct {
+	ApplicationARN types.String `tfsdk:"application_arn"`
+	InstanceARN    fwtypes.ARN  `tfsdk:"instance_arn"`
+	Role           fwtypes.ARN  `tfsdk:"role"`
+}
+
+// identityCenterConfig returns the configured IAM Identity Center settings, if any.
+func (m *webAppResourceModel) identityCenterConfig(ctx context.Context) (*identityCenterConfigModel, diag.Diagnostics) {
+	identityProviderDetails, diags := m.IdentityProviderDetails.ToPtr(ctx)
+	if diags.HasError() || identityProviderDetails == nil {
+		return nil, diags
+	}
+
+	return identityProviderDetails.IdentityCenterConfig.ToPtr(ctx)
+}
+
+// flatten sets the model's values from a described web app.
+// The identity provider details and web app units are union types which AutoFlEx does not support.
+func (m *webAppResourceModel) flatten(ctx context.Context, apiObject *awstypes.DescribedWebApp) {
+	m.AccessEndpoint = fwflex.StringToFramework(ctx, apiObject.AccessEndpoint)
+	m.ARN = fwflex.StringToFramework(ctx, apiObject.Arn)
+	m.WebAppEndpoint = fwflex.StringToFramework(ctx, apiObject.WebAppEndpoint)
+	m.WebAppID = fwflex.StringToFramework(ctx, apiObject.WebAppId)
+
+	if v, ok := apiObject.DescribedIdentityProviderDetails.(*awstypes.DescribedWebAppIdentityProviderDetailsMemberIdentityCenterConfig); ok {
+		identityCenterConfig := &identityCenterConfigModel{
+			ApplicationARN: fwflex.StringToFramework(ctx, v.Value.ApplicationArn),
+			InstanceARN:    fwtypes.ARNValue(aws.ToString(v.Value.InstanceArn)),
+			Role:           fwtypes.ARNValue(aws.ToString(v.Value.Role)),
+		}
+
+		m.IdentityProviderDetails = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &webAppIdentityProviderDetailsModel{
+			IdentityCenterConfig: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, identityCenterConfig),
+		})
+	}
+
+	if v, ok := apiObject.WebAppUnits.(*awstypes.WebAppUnitsMemberProvisioned); ok {
+		m.WebAppUnits = fwflex.Int32ValueToFramework(ctx, v.Value)
+	}
+}
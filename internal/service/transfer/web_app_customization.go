@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_transfer_web_app_customization", name="Web App Customization")
+func newWebAppCustomizationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &webAppCustomizationResource{}
+
+	return r, nil
+}
+
+type webAppCustomizationResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*webAppCustomizationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_transfer_web_app_customization"
+}
+
+func (r *webAppCustomizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"favicon_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 27960),
+				},
+			},
+			"logo_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 69900),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"web_app_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *webAppCustomizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data webAppCustomizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	input, err := data.updateInput()
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	if _, err := conn.UpdateWebAppCustomization(ctx, input); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	customization, err := findWebAppCustomizationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, customization.Arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *webAppCustomizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data webAppCustomizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	output, err := findWebAppCustomizationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.FaviconFile = flattenWebAppCustomizationFile(output.FaviconFile)
+	data.LogoFile = flattenWebAppCustomizationFile(output.LogoFile)
+	data.Title = fwflex.StringToFramework(ctx, output.Title)
+	data.WebAppID = fwflex.StringToFramework(ctx, output.WebAppId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *webAppCustomizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data webAppCustomizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	input, err := data.updateInput()
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	if _, err := conn.UpdateWebAppCustomization(ctx, input); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *webAppCustomizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data webAppCustomizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TransferClient(ctx)
+
+	id := data.WebAppID.ValueString()
+	_, err := conn.DeleteWebAppCustomization(ctx, &transfer.DeleteWebAppCustomizationInput{
+		WebAppId: aws.String(id),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Transfer Web App (%s) Customization", id), err.Error())
+
+		return
+	}
+}
+
+func (r *webAppCustomizationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("web_app_id"), request, response)
+}
+
+func findWebAppCustomizationByID(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedWebAppCustomization, error) {
+	input := &transfer.DescribeWebAppCustomizationInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWebAppCustomization(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebAppCustomization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebAppCustomization, nil
+}
+
+type webAppCustomizationResourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	FaviconFile types.String `tfsdk:"favicon_file"`
+	LogoFile    types.String `tfsdk:"logo_file"`
+	Title       types.String `tfsdk:"title"`
+	WebAppID    types.String `tfsdk:"web_app_id"`
+}
+
+// updateInput returns the UpdateWebAppCustomization input for the model.
+// Unset values are sent as empty so that removing them from configuration resets the customization.
+func (m *webAppCustomizationResourceModel) updateInput() (*transfer.UpdateWebAppCustomizationInput, error) {
+	input := &transfer.UpdateWebAppCustomizationInput{
+		FaviconFile: []byte{},
+		LogoFile:    []byte{},
+		Title:       aws.String(m.Title.ValueString()),
+		WebAppId:    aws.String(m.WebAppID.ValueString()),
+	}
+
+	if v := m.FaviconFile.ValueString(); v != "" {
+		b, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding favicon_file: %w", err)
+		}
+
+		input.FaviconFile = b
+	}
+
+	if v := m.LogoFile.ValueString(); v != "" {
+		b, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding logo_file: %w", err)
+		}
+
+		input.LogoFile = b
+	}
+
+	return input, nil
+}
+
+func flattenWebAppCustomizationFile(v []byte) types.String {
+	if len(v) == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(base64.StdEncoding.EncodeToString(v))
+}
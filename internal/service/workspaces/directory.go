@@ -41,6 +41,27 @@ func resourceDirectory() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"active_directory_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDomainName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"service_account_secret_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrAlias: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,7 +72,8 @@ func resourceDirectory() *schema.Resource {
 			},
 			"directory_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"directory_name": {
@@ -150,6 +172,13 @@ func resourceDirectory() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"user_identity_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.UserIdentityType](),
+			},
 			"workspace_access_properties": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -233,10 +262,28 @@ func resourceDirectory() *schema.Resource {
 					},
 				},
 			},
+			"workspace_directory_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_directory_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"workspace_security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"workspace_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.WorkspaceTypePersonal,
+				ValidateDiagFunc: enum.Validate[types.WorkspaceType](),
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -247,32 +294,57 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
 
-	directoryID := d.Get("directory_id").(string)
+	workspaceType := types.WorkspaceType(d.Get("workspace_type").(string))
 	input := &workspaces.RegisterWorkspaceDirectoryInput{
-		DirectoryId:       aws.String(directoryID),
-		EnableSelfService: aws.Bool(false), // this is handled separately below
-		EnableWorkDocs:    aws.Bool(false),
-		Tenancy:           types.TenancyShared,
-		Tags:              getTagsIn(ctx),
+		Tags:          getTagsIn(ctx),
+		WorkspaceType: workspaceType,
+	}
+
+	// Self-service, WorkDocs and tenancy only apply to personal WorkSpaces.
+	if workspaceType == types.WorkspaceTypePersonal {
+		input.EnableSelfService = aws.Bool(false) // this is handled separately below
+		input.EnableWorkDocs = aws.Bool(false)
+		input.Tenancy = types.TenancyShared
+	}
+
+	if v, ok := d.GetOk("active_directory_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ActiveDirectoryConfig = expandActiveDirectoryConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk(names.AttrSubnetIDs); ok {
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("user_identity_type"); ok {
+		input.UserIdentityType = types.UserIdentityType(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_directory_description"); ok {
+		input.WorkspaceDirectoryDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_directory_name"); ok {
+		input.WorkspaceDirectoryName = aws.String(v.(string))
+	}
+
 	const (
 		timeout = 2 * time.Minute
 	)
-	_, err := tfresource.RetryWhenIsA[*types.InvalidResourceStateException](ctx, timeout,
+	outputRaw, err := tfresource.RetryWhenIsA[*types.InvalidResourceStateException](ctx, timeout,
 		func() (interface{}, error) {
 			return conn.RegisterWorkspaceDirectory(ctx, input)
 		})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "registering WorkSpaces Directory (%s): %s", directoryID, err)
+		return sdkdiag.AppendErrorf(diags, "registering WorkSpaces Directory (%s): %s", d.Get("directory_id").(string), err)
 	}
 
-	d.SetId(directoryID)
+	// Directories for pools that aren't backed by AWS Directory Service are assigned an ID on registration.
+	d.SetId(aws.ToString(outputRaw.(*workspaces.RegisterWorkspaceDirectoryOutput).DirectoryId))
 
 	if _, err := waitDirectoryRegistered(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for WorkSpaces Directory (%s) create: %s", d.Id(), err)
@@ -362,6 +434,9 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Directory (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("active_directory_config", flattenActiveDirectoryConfig(directory.ActiveDirectoryConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting active_directory_config: %s", err)
+	}
 	d.Set(names.AttrAlias, directory.Alias)
 	d.Set("directory_id", directory.DirectoryId)
 	d.Set("directory_name", directory.DirectoryName)
@@ -377,13 +452,17 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting saml_properties: %s", err)
 	}
 	d.Set(names.AttrSubnetIDs, directory.SubnetIds)
+	d.Set("user_identity_type", directory.UserIdentityType)
 	if err := d.Set("workspace_access_properties", flattenWorkspaceAccessProperties(directory.WorkspaceAccessProperties)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting workspace_access_properties: %s", err)
 	}
 	if err := d.Set("workspace_creation_properties", flattenDefaultWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting workspace_creation_properties: %s", err)
 	}
+	d.Set("workspace_directory_description", directory.WorkspaceDirectoryDescription)
+	d.Set("workspace_directory_name", directory.WorkspaceDirectoryName)
 	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+	d.Set("workspace_type", directory.WorkspaceType)
 
 	return diags
 }
@@ -637,6 +716,24 @@ func waitDirectoryDeregistered(ctx context.Context, conn *workspaces.Client, dir
 	return nil, err
 }
 
+func expandActiveDirectoryConfig(tfMap map[string]interface{}) *types.ActiveDirectoryConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ActiveDirectoryConfig{}
+
+	if v, ok := tfMap[names.AttrDomainName].(string); ok && v != "" {
+		apiObject.DomainName = aws.String(v)
+	}
+
+	if v, ok := tfMap["service_account_secret_arn"].(string); ok && v != "" {
+		apiObject.ServiceAccountSecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandWorkspaceAccessProperties(tfList []interface{}) *types.WorkspaceAccessProperties {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -767,6 +864,19 @@ func expandWorkspaceCreationProperties(tfList []interface{}) *types.WorkspaceCre
 	return apiObject
 }
 
+func flattenActiveDirectoryConfig(apiObject *types.ActiveDirectoryConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrDomainName:         aws.ToString(apiObject.DomainName),
+		"service_account_secret_arn": aws.ToString(apiObject.ServiceAccountSecretArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenWorkspaceAccessProperties(apiObject *types.WorkspaceAccessProperties) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -83,6 +83,7 @@ func testAccDirectory_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.enable_maintenance_mode", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.0.user_enabled_as_local_administrator", acctest.CtTrue),
 					resource.TestCheckResourceAttrSet(resourceName, "workspace_security_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_type", string(types.WorkspaceTypePersonal)),
 				),
 			},
 			{
@@ -505,6 +506,42 @@ func testAccDirectory_ipGroupIDs(t *testing.T) {
 	})
 }
 
+func testAccDirectory_pools(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+	resourceName := "aws_workspaces_directory.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_pools(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "directory_id"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user_identity_type", string(types.UserIdentityTypeCustomerManaged)),
+					resource.TestCheckResourceAttr(resourceName, "workspace_directory_description", "WorkSpaces Pools directory"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_directory_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "workspace_type", string(types.WorkspaceTypePools)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
@@ -568,7 +605,7 @@ func testAccPreCheckDirectory(ctx context.Context, t *testing.T) {
 	}
 }
 
-func testAccDirectoryConfig_Prerequisites(rName, domain string) string {
+func testAccDirectoryConfig_vpcBase(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
 		//lintignore:AWSAT003
@@ -610,7 +647,13 @@ resource "aws_subnet" "secondary" {
     Name = "tf-testacc-workspaces-directory-%[1]s-secondary"
   }
 }
+`, rName))
+}
 
+func testAccDirectoryConfig_Prerequisites(rName, domain string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_vpcBase(rName),
+		fmt.Sprintf(`
 resource "aws_directory_service_directory" "main" {
   size     = "Small"
   name     = %[2]q
@@ -933,3 +976,22 @@ resource "aws_workspaces_directory" "test" {
 }
 `, rName))
 }
+
+func testAccDirectoryConfig_pools(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_vpcBase(rName),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "main" {
+  subnet_ids = [aws_subnet.primary.id, aws_subnet.secondary.id]
+
+  workspace_type                  = "POOLS"
+  user_identity_type              = "CUSTOMER_MANAGED"
+  workspace_directory_name        = %[1]q
+  workspace_directory_description = "WorkSpaces Pools directory"
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName))
+}
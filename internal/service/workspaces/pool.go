@@ -0,0 +1,601 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspaces_pool", name="Pool")
+// @Tags(identifierAttribute="id")
+func newPoolResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &poolResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type poolResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*poolResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspaces_pool"
+}
+
+func (r *poolResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bundle_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"directory_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkspacesPoolState](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"application_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[applicationSettingsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrS3BucketName: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"settings_group": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(100),
+							},
+						},
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ApplicationSettingsStatusEnum](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"capacity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"desired_user_sessions": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"timeout_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[timeoutSettingsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"disconnect_timeout_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(60, 36000),
+							},
+						},
+						"idle_disconnect_timeout_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(0, 36000),
+							},
+						},
+						"max_user_duration_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(600, 432000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *poolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &workspaces.CreateWorkspacesPoolInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.PoolName = aws.String(name)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkspacesPool(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Pool (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.WorkspacesPool.PoolId)
+
+	pool, err := waitPoolCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, pool)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *poolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	pool, err := findPoolByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, pool)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *poolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	if !new.ApplicationSettings.Equal(old.ApplicationSettings) ||
+		!new.BundleID.Equal(old.BundleID) ||
+		!new.Capacity.Equal(old.Capacity) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DirectoryID.Equal(old.DirectoryID) ||
+		!new.TimeoutSettings.Equal(old.TimeoutSettings) {
+		input := &workspaces.UpdateWorkspacesPoolInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.PoolId = new.ID.ValueStringPointer()
+
+		_, err := conn.UpdateWorkspacesPool(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Pool (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		pool, err := waitPoolUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, pool)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.State = old.State
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *poolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	id := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A pool must be stopped before it can be terminated.
+	pool, err := findPoolByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	if state := pool.State; state == awstypes.WorkspacesPoolStateRunning || state == awstypes.WorkspacesPoolStateStarting {
+		_, err := conn.StopWorkspacesPool(ctx, &workspaces.StopWorkspacesPoolInput{
+			PoolId: aws.String(id),
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping WorkSpaces Pool (%s)", id), err.Error())
+
+			return
+		}
+
+		if _, err := waitPoolStopped(ctx, conn, id, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) stop", id), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.TerminateWorkspacesPool(ctx, &workspaces.TerminateWorkspacesPoolInput{
+		PoolId: aws.String(id),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *poolResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPoolByID(ctx context.Context, conn *workspaces.Client, id string) (*awstypes.WorkspacesPool, error) {
+	input := &workspaces.DescribeWorkspacesPoolsInput{
+		PoolIds: []string{id},
+	}
+
+	return findPool(ctx, conn, input)
+}
+
+func findPool(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) (*awstypes.WorkspacesPool, error) {
+	output, err := findPools(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPools(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) ([]awstypes.WorkspacesPool, error) {
+	var output []awstypes.WorkspacesPool
+
+	err := describeWorkspacesPoolsPages(ctx, conn, input, func(page *workspaces.DescribeWorkspacesPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.WorkspacesPools...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusPool(ctx context.Context, conn *workspaces.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitPoolCreated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateCreating),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolUpdated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateUpdating),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolStopped(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStarting, awstypes.WorkspacesPoolStateStopping),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateDeleting, awstypes.WorkspacesPoolStateStopped),
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func poolError(apiObjects []awstypes.WorkspacesPoolError) error {
+	poolErrs := make([]error, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		poolErrs = append(poolErrs, fmt.Errorf("%s: %s", apiObject.ErrorCode, strings.TrimSpace(aws.ToString(apiObject.ErrorMessage))))
+	}
+
+	return errors.Join(poolErrs...)
+}
+
+type poolResourceModel struct {
+	ApplicationSettings fwtypes.ListNestedObjectValueOf[applicationSettingsModel] `tfsdk:"application_settings"`
+	ARN                 types.String                                              `tfsdk:"arn"`
+	BundleID            types.String                                              `tfsdk:"bundle_id"`
+	Capacity            fwtypes.ListNestedObjectValueOf[capacityModel]            `tfsdk:"capacity"`
+	Description         types.String                                              `tfsdk:"description"`
+	DirectoryID         types.String                                              `tfsdk:"directory_id"`
+	ID                  types.String                                              `tfsdk:"id"`
+	Name                types.String                                              `tfsdk:"name"`
+	State               fwtypes.StringEnum[awstypes.WorkspacesPoolState]          `tfsdk:"state"`
+	Tags                tftags.Map                                                `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                `tfsdk:"tags_all"`
+	Timeouts            timeouts.Value                                            `tfsdk:"timeouts"`
+	TimeoutSettings     fwtypes.ListNestedObjectValueOf[timeoutSettingsModel]     `tfsdk:"timeout_settings"`
+}
+
+func (m *poolResourceModel) flatten(ctx context.Context, pool *awstypes.WorkspacesPool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, pool, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = fwflex.StringToFramework(ctx, pool.PoolArn)
+	if v := pool.CapacityStatus; v != nil {
+		m.Capacity = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &capacityModel{
+			DesiredUserSessions: fwflex.Int32ToFramework(ctx, v.DesiredUserSessions),
+		})
+	}
+	m.ID = fwflex.StringToFramework(ctx, pool.PoolId)
+	m.Name = fwflex.StringToFramework(ctx, pool.PoolName)
+
+	return diags
+}
+
+type applicationSettingsModel struct {
+	S3BucketName  types.String                                               `tfsdk:"s3_bucket_name"`
+	SettingsGroup types.String                                               `tfsdk:"settings_group"`
+	Status        fwtypes.StringEnum[awstypes.ApplicationSettingsStatusEnum] `tfsdk:"status"`
+}
+
+type capacityModel struct {
+	DesiredUserSessions types.Int64 `tfsdk:"desired_user_sessions"`
+}
+
+type timeoutSettingsModel struct {
+	DisconnectTimeoutInSeconds     types.Int64 `tfsdk:"disconnect_timeout_in_seconds"`
+	IdleDisconnectTimeoutInSeconds types.Int64 `tfsdk:"idle_disconnect_timeout_in_seconds"`
+	MaxUserDurationInSeconds       types.Int64 `tfsdk:"max_user_duration_in_seconds"`
+}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspaces "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	resourceName := "aws_workspaces_pool.test"
+	directoryResourceName := "aws_workspaces_directory.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", "data.aws_workspaces_bundle.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.desired_user_sessions", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test pool"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	resourceName := "aws_workspaces_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspaces.ResourcePool, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPool_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	resourceName := "aws_workspaces_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPoolConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPoolConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccPool_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspacesPool
+	rName := sdkacctest.RandString(8)
+	resourceName := "aws_workspaces_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.desired_user_sessions", "1"),
+				),
+			},
+			{
+				Config: testAccPoolConfig_full(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.0.settings_group", rName),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.0.status", string(awstypes.ApplicationSettingsStatusEnumEnabled)),
+					resource.TestCheckResourceAttrSet(resourceName, "application_settings.0.s3_bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.desired_user_sessions", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated test pool"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.0.disconnect_timeout_in_seconds", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.0.idle_disconnect_timeout_in_seconds", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_settings.0.max_user_duration_in_seconds", "2000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_pool" {
+				continue
+			}
+
+			_, err := tfworkspaces.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Pool %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPoolExists(ctx context.Context, n string, v *awstypes.WorkspacesPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		output, err := tfworkspaces.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPoolConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryConfig_pools(rName), `
+data "aws_workspaces_bundle" "test" {
+  owner = "AMAZON"
+  name  = "Standard with Windows 10 (Server 2022 based) (WSP)"
+}
+`)
+}
+
+func testAccPoolConfig_basic(rName string, desiredUserSessions int) string {
+	return acctest.ConfigCompose(testAccPoolConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspaces_pool" "test" {
+  bundle_id    = data.aws_workspaces_bundle.test.id
+  description  = "Test pool"
+  directory_id = aws_workspaces_directory.main.id
+  name         = %[1]q
+
+  capacity {
+    desired_user_sessions = %[2]d
+  }
+}
+`, rName, desiredUserSessions))
+}
+
+func testAccPoolConfig_full(rName string, desiredUserSessions int) string {
+	return acctest.ConfigCompose(testAccPoolConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspaces_pool" "test" {
+  bundle_id    = data.aws_workspaces_bundle.test.id
+  description  = "Updated test pool"
+  directory_id = aws_workspaces_directory.main.id
+  name         = %[1]q
+
+  application_settings {
+    status         = "ENABLED"
+    settings_group = %[1]q
+  }
+
+  capacity {
+    desired_user_sessions = %[2]d
+  }
+
+  timeout_settings {
+    disconnect_timeout_in_seconds      = 2000
+    idle_disconnect_timeout_in_seconds = 2000
+    max_user_duration_in_seconds       = 2000
+  }
+}
+`, rName, desiredUserSessions))
+}
+
+func testAccPoolConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPoolConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspaces_pool" "test" {
+  bundle_id    = data.aws_workspaces_bundle.test.id
+  description  = "Test pool"
+  directory_id = aws_workspaces_directory.main.id
+  name         = %[1]q
+
+  capacity {
+    desired_user_sessions = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPoolConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPoolConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspaces_pool" "test" {
+  bundle_id    = data.aws_workspaces_bundle.test.id
+  description  = "Test pool"
+  directory_id = aws_workspaces_directory.main.id
+  name         = %[1]q
+
+  capacity {
+    desired_user_sessions = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -17,6 +17,7 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			acctest.CtBasic:               testAccDirectory_basic,
 			acctest.CtDisappears:          testAccDirectory_disappears,
 			"ipGroupIds":                  testAccDirectory_ipGroupIDs,
+			"pools":                       testAccDirectory_pools,
 			"selfServicePermissions":      testAccDirectory_selfServicePermissions,
 			"subnetIDs":                   testAccDirectory_subnetIDs,
 			"tags":                        testAccDirectory_tags,
@@ -31,6 +32,12 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"multipleDirectories": testAccIPGroup_MultipleDirectories,
 			"tags":                testAccIPGroup_tags,
 		},
+		"Pool": {
+			acctest.CtBasic:      testAccPool_basic,
+			acctest.CtDisappears: testAccPool_disappears,
+			"tags":               testAccPool_tags,
+			"update":             testAccPool_update,
+		},
 		"Workspace": {
 			acctest.CtBasic:          testAccWorkspace_basic,
 			"recreate":               testAccWorkspace_recreate,
@@ -18,6 +18,22 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourcePermissions represents the IAM actions called by each of a resource's operations.
+type ServicePackageResourcePermissions struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
+// ServicePackageResourceMovedFrom represents a resource type, typically a deprecated one,
+// whose state can be moved to a Plugin SDK resource by a `moved` block.
+type ServicePackageResourceMovedFrom struct {
+	TypeName string
+	// StateMover transforms the source resource's state to the target resource's current schema.
+	// If nil, the source and target resources share a schema and the state is moved unchanged.
+	StateMover schema.StateUpgradeFunc
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
@@ -44,17 +60,21 @@ type ServicePackageFrameworkResource struct {
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory          func() *schema.Resource
+	TypeName         string
+	Name             string
+	Tags             *ServicePackageResourceTags
+	OverrideProvider bool
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory          func() *schema.Resource
+	TypeName         string
+	Name             string
+	Tags             *ServicePackageResourceTags
+	Permissions      *ServicePackageResourcePermissions
+	MovedFrom        []ServicePackageResourceMovedFrom
+	OverrideProvider bool
 }
@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"log"
 	"runtime/debug"
 
@@ -26,7 +27,7 @@ func main() {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 	if err != nil {
 		log.Fatal(err)
@@ -44,6 +45,13 @@ func main() {
 		serveOpts...,
 	)
 
+	// The provider has stopped. Release the resources held by the configured provider instance, e.g. the audit log.
+	if v, ok := primary.Meta().(io.Closer); ok {
+		if err := v.Close(); err != nil {
+			log.Printf("[WARN] closing provider: %s", err)
+		}
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
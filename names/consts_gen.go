@@ -30,6 +30,7 @@ const (
 	Batch                        = "batch"
 	Bedrock                      = "bedrock"
 	BedrockAgent                 = "bedrockagent"
+	BillingConductor             = "billingconductor"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -150,6 +151,7 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	MailManager                  = "mailmanager"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -202,6 +204,7 @@ const (
 	S3                           = "s3"
 	S3Control                    = "s3control"
 	S3Outposts                   = "s3outposts"
+	S3Tables                     = "s3tables"
 	SES                          = "ses"
 	SESV2                        = "sesv2"
 	SFN                          = "sfn"
@@ -280,6 +283,7 @@ const (
 	BatchServiceID                        = "Batch"
 	BedrockServiceID                      = "Bedrock"
 	BedrockAgentServiceID                 = "Bedrock Agent"
+	BillingConductorServiceID             = "billingconductor"
 	BudgetsServiceID                      = "Budgets"
 	CEServiceID                           = "Cost Explorer"
 	CURServiceID                          = "Cost and Usage Report Service"
@@ -400,6 +404,7 @@ const (
 	MQServiceID                           = "mq"
 	MWAAServiceID                         = "MWAA"
 	Macie2ServiceID                       = "Macie2"
+	MailManagerServiceID                  = "MailManager"
 	MediaConnectServiceID                 = "MediaConnect"
 	MediaConvertServiceID                 = "MediaConvert"
 	MediaLiveServiceID                    = "MediaLive"
@@ -452,6 +457,7 @@ const (
 	S3ServiceID                           = "S3"
 	S3ControlServiceID                    = "S3 Control"
 	S3OutpostsServiceID                   = "S3Outposts"
+	S3TablesServiceID                     = "S3Tables"
 	SESServiceID                          = "SES"
 	SESV2ServiceID                        = "SESv2"
 	SFNServiceID                          = "SFN"
@@ -942,6 +942,10 @@ service "billingconductor" {
     go_v1_client_typename = "BillingConductor"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListBillingGroups"
+  }
+
   resource_prefix {
     correct = "aws_billingconductor_"
   }
@@ -949,7 +953,6 @@ service "billingconductor" {
   provider_package_correct = "billingconductor"
   doc_prefix               = ["billingconductor_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "braket" {
@@ -5454,6 +5457,34 @@ service "m2" {
   brand                    = "AWS"
 }
 
+service "mailmanager" {
+  cli_v2_command {
+    aws_cli_v2_command           = "mailmanager"
+    aws_cli_v2_command_no_dashes = "mailmanager"
+  }
+
+  sdk {
+    id = "MailManager"
+  }
+
+  names {
+    provider_name_upper = "MailManager"
+    human_friendly      = "SES Mail Manager"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListArchives"
+  }
+
+  resource_prefix {
+    correct = "aws_mailmanager_"
+  }
+
+  provider_package_correct = "mailmanager"
+  doc_prefix               = ["mailmanager_"]
+  brand                    = "Amazon"
+}
+
 service "managedblockchain" {
   sdk {
     id = "ManagedBlockchain"
@@ -7532,6 +7563,29 @@ service "s3outposts" {
   brand                    = "AWS"
 }
 
+service "s3tables" {
+  sdk {
+    id = "S3Tables"
+  }
+
+  names {
+    provider_name_upper = "S3Tables"
+    human_friendly      = "S3 Tables"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListTableBuckets"
+  }
+
+  resource_prefix {
+    correct = "aws_s3tables_"
+  }
+
+  provider_package_correct = "s3tables"
+  doc_prefix               = ["s3tables_"]
+  brand                    = "Amazon"
+}
+
 service "sagemaker" {
   sdk {
     id = "SageMaker"
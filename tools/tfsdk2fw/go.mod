@@ -19,22 +19,22 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go v1.55.5 // indirect
-	github.com/aws/aws-sdk-go-v2 v1.32.4 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.6 // indirect
+	github.com/aws/aws-sdk-go-v2 v1.36.3 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.28.3 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.44 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.19 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.37 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.23 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.23 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.23 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.25 // indirect
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.34.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/account v1.21.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/acm v1.30.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.37.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/amp v1.30.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/amplify v1.27.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/amplify v1.29.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.27.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.24.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.35.3 // indirect
@@ -57,6 +57,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.7.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.22.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.27.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/billingconductor v1.20.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.28.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.8.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/chime v1.34.5 // indirect
@@ -100,7 +101,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/databrew v1.33.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/dataexchange v1.33.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/datapipeline v1.25.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/datasync v1.43.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/datasync v1.45.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/datazone v1.23.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/dax v1.23.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/detective v1.31.5 // indirect
@@ -113,7 +114,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.14.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/drs v1.30.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.36.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.187.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.212.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.36.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.27.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.49.2 // indirect
@@ -149,11 +150,11 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.38.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.25.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.33.0 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.0 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.4.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.4.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.10.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.4 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.15 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.19.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iot v1.59.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iotanalytics v1.26.5 // indirect
@@ -170,7 +171,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kinesisvideo v1.27.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/kms v1.37.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.38.1 // indirect
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.66.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.8.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice v1.28.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.49.5 // indirect
@@ -180,6 +181,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/lookoutmetrics v1.31.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/m2 v1.18.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/macie2 v1.43.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/mailmanager v1.13.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/mediaconnect v1.35.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/mediaconvert v1.61.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.62.5 // indirect
@@ -221,7 +223,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/rekognition v1.45.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/resiliencehub v1.27.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.16.0 // indirect
-	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.27.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.27.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.25.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.16.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.46.1 // indirect
@@ -231,9 +233,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/route53recoveryreadiness v1.21.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.33.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/rum v1.21.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/s3 v1.66.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.71.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.50.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.28.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/s3tables v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.166.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.12.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.28.6 // indirect
@@ -268,7 +271,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.6.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.29.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.41.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/transfer v1.53.3 // indirect
+	github.com/aws/aws-sdk-go-v2/service/transfer v1.54.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.20.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.12.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/waf v1.25.5 // indirect
@@ -279,7 +282,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.48.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.24.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/xray v1.29.5 // indirect
-	github.com/aws/smithy-go v1.22.0 // indirect
+	github.com/aws/smithy-go v1.22.2 // indirect
 	github.com/beevik/etree v1.4.1 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
 	github.com/cedar-policy/cedar-go v0.1.0 // indirect